@@ -149,14 +149,14 @@ func TestEQuantile(t *testing.T) {
 
 	// check ECrI
 	fmt.Println("Credible interval test against Norm")
-	x, _ = ECrI(data, 0.95)
+	x, _ = ECrI(data, 0.05)
 	y = -1.96873684772125
 	if !check(x, y) {
 		t.Error()
 		fmt.Println(x, y)
 	}
 
-	_, x = ECrI(data, 0.95)
+	_, x = ECrI(data, 0.05)
 	y = 1.9567572312964
 	if !check(x, y) {
 		t.Error()
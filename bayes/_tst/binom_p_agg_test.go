@@ -0,0 +1,49 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBinomPiPostAggregated(t *testing.T) {
+	fmt.Println("Testing BinomPiPostAggregated")
+	a, b := 1.0, 1.0
+	// pooling two rows equals a single-row update with the combined counts
+	αPost, βPost, _, _ := BinomPiPostAggregated([]int64{12, 30}, []int64{100, 250}, a, b)
+	if !check(αPost, a+42) || !check(βPost, b+308) {
+		fmt.Println("failed: ", αPost, βPost)
+		t.Error()
+	}
+	x := αPost / (αPost + βPost)
+	y := BinomPiPostMean(a, b, 350, 42)
+	if !check(x, y) {
+		fmt.Println("failed: ", x, y)
+		t.Error()
+	}
+
+	// homogeneous strata
+	_, _, chi2, pVal := BinomPiPostAggregated([]int64{12, 30, 25}, []int64{100, 250, 200}, a, b)
+	if pVal < 0.05 {
+		fmt.Println("failed: homogeneous strata flagged ", chi2, pVal)
+		t.Error()
+	}
+
+	// third stratum with a very different rate; R: chisq.test(cbind(c(12,30,80), c(88,220,120)))$statistic = 57.80604
+	_, _, chi2, pVal = BinomPiPostAggregated([]int64{12, 30, 80}, []int64{100, 250, 200}, a, b)
+	if !check(chi2, 57.80604) || pVal > 0.001 {
+		fmt.Println("failed: heterogeneous strata not flagged ", chi2, pVal)
+		t.Error()
+	}
+
+	// a row with no trials is left out of the test
+	_, _, chi2, pVal = BinomPiPostAggregated([]int64{12, 30, 0, 80}, []int64{100, 250, 0, 200}, a, b)
+	if !check(chi2, 57.80604) || isNaN(pVal) || pVal > 0.001 {
+		fmt.Println("failed: empty stratum ", chi2, pVal)
+		t.Error()
+	}
+	_, _, chi2, pVal = BinomPiPostAggregated([]int64{12, 0}, []int64{100, 0}, a, b)
+	if chi2 != 0 || pVal != 1 {
+		fmt.Println("failed: single nonempty stratum ", chi2, pVal)
+		t.Error()
+	}
+}
@@ -0,0 +1,83 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+)
+
+func lnBeta(a, b float64) float64 {
+	la, _ := math.Lgamma(a)
+	lb, _ := math.Lgamma(b)
+	lab, _ := math.Lgamma(a + b)
+	return la + lb - lab
+}
+
+func TestBinomPiDiff(t *testing.T) {
+	fmt.Println("Testing BinomPiDiffCrIBPri, BinomPiDiffNextBPri, BinomPiDiffProbGreaterBPri")
+	var y1, n1, y2, n2 int64 = 40, 100, 30, 120
+	a1, b1, a2, b2 := 1.0, 1.0, 2.0, 3.0
+
+	// posterior variance of the difference is the sum of the Beta variances
+	betaVar := func(a, b float64) float64 { return a * b / ((a + b) * (a + b) * (a + b + 1)) }
+	v := betaVar(41, 61) + betaVar(32, 93)
+	if x := BinomPiDiffVarNApprox(a1, b1, a2, b2, n1, n2, y1, y2); !check(x, v) {
+		t.Error()
+		fmt.Println(x, v)
+	}
+
+	// the Normal approximation against quantiles of the exact posterior of π1 - π2
+	const draws = 100000
+	d := make([]float64, draws)
+	above := 0
+	for i := range d {
+		d[i] = BinomPiDiffNextBPri(y1, n1, y2, n2, a1, b1, a2, b2)
+		if d[i] > 0 {
+			above++
+		}
+	}
+	sort.Float64s(d)
+	lo, hi := BinomPiDiffCrIBPri(y1, n1, y2, n2, a1, b1, a2, b2, 0.05)
+	if math.Abs(lo-d[draws/40]) > 0.005 || math.Abs(hi-d[draws-draws/40]) > 0.005 {
+		t.Error()
+		fmt.Println(lo, hi, d[draws/40], d[draws-draws/40])
+	}
+
+	// P(π1 > π2) against the closed form for integer a1post (Evan Miller 2015), and the simulation
+	a1p, b1p, a2p, b2p := 41.0, 61.0, 32.0, 93.0
+	exact := 0.0
+	for i := 0.0; i < a1p; i++ {
+		exact += math.Exp(lnBeta(a2p+i, b2p+b1p) - math.Log(b1p+i) - lnBeta(1+i, b1p) - lnBeta(a2p, b2p))
+	}
+	p := BinomPiDiffProbGreaterBPri(y1, n1, y2, n2, a1, b1, a2, b2)
+	if math.Abs(p-exact) > 1e-4 || math.Abs(p-float64(above)/draws) > 0.005 {
+		t.Error()
+		fmt.Println(p, exact, float64(above)/draws)
+	}
+	// swapping the groups gives the complement
+	q := BinomPiDiffProbGreaterBPri(y2, n2, y1, n1, a2, b2, a1, b1)
+	if math.Abs(p+q-1) > 1e-4 {
+		t.Error()
+		fmt.Println(p, q)
+	}
+	// identical groups are a toss-up
+	if p := BinomPiDiffProbGreaterBPri(7, 20, 7, 20, 0.5, 0.5, 0.5, 0.5); math.Abs(p-0.5) > 1e-6 {
+		t.Error()
+		fmt.Println(p)
+	}
+
+	if !panics(func() { BinomPiDiffCrIBPri(11, 10, 3, 10, 1, 1, 1, 1, 0.05) }) {
+		t.Error()
+		fmt.Println("no panic for y > n")
+	}
+	// Haldane's prior, a = b = 0, is accepted when the posterior is proper
+	if panics(func() { BinomPiDiffCrIBPri(4, 10, 3, 10, 0, 0, 0, 0, 0.05) }) {
+		t.Error()
+		fmt.Println("panic for zero prior parameters")
+	}
+	if !panics(func() { BinomPiDiffCrIBPri(0, 10, 3, 10, 0, 0, 1, 1, 0.05) }) {
+		t.Error()
+		fmt.Println("no panic for an improper posterior")
+	}
+}
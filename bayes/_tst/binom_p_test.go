@@ -0,0 +1,90 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Posterior Beta(8, 14): flat prior, 7 successes in 20 trials.
+// Posterior Beta(28, 77): Beta(2, 3) prior, 26 successes in 100 trials.
+// Reference values from numerical integration of the Beta PDF.
+func TestBinomPiBPri(t *testing.T) {
+	fmt.Println("Testing BinomPi posterior summaries, Beta prior")
+	k := []int64{7, 26}
+	n := []int64{20, 100}
+	α := []float64{1, 2}
+	β := []float64{1, 3}
+	mean := []float64{0.3636364, 0.2666667}
+	vari := []float64{0.01006109, 0.001844864}
+	median := []float64{0.3594343, 0.2651805}
+	lo := []float64{0.1810716, 0.1869398}
+	hi := []float64{0.5696755, 0.3548101}
+	for i := range k {
+		if !check(BinomPiPostMean(α[i], β[i], n[i], k[i]), mean[i]) {
+			t.Error("mean", i)
+		}
+		if !check(BinomPiPostVar(α[i], β[i], n[i], k[i]), vari[i]) {
+			t.Error("variance", i)
+		}
+		if !check(BinomPiPostMedian(α[i], β[i], n[i], k[i]), median[i]) {
+			t.Error("median", i)
+		}
+		l, h := BinomPiCrIBPri(k[i], n[i], α[i], β[i], 0.05)
+		if !check(l, lo[i]) || !check(h, hi[i]) {
+			t.Error("credible interval", i)
+			fmt.Println(l, h, lo[i], hi[i])
+		}
+		cdf := BinomPiCDFBPri(k[i], n[i], α[i], β[i])
+		qtl := BinomPiQtlBPri(k[i], n[i], α[i], β[i])
+		if !check(cdf(h)-cdf(l), 0.95) || !check(qtl(0.5), median[i]) {
+			t.Error("CDF or Qtl", i)
+		}
+	}
+
+	fmt.Println("Testing BinomPiNextBPri")
+	rand.Seed(1)
+	const draws = 20000
+	sum := 0.0
+	for i := 0; i < draws; i++ {
+		sum += BinomPiNextBPri(26, 100, 2, 3)
+	}
+	// 5 standard errors of the mean
+	if math.Abs(sum/draws-mean[1]) > 5*math.Sqrt(vari[1]/draws) {
+		t.Error("sample mean", sum/draws)
+	}
+
+	if panicMsg(func() { BinomPiCrIBPri(21, 20, 1, 1, 0.05) }) == "" {
+		t.Error("k > n accepted")
+	}
+}
+
+// Posterior Beta(8, 14): flat prior, 7 successes in 20 trials; P(π <= 0.2) = 0.04305.
+func TestBinomPiTst(t *testing.T) {
+	fmt.Println("Testing BinomPiOneSidedTst and BinomPiTwoSidedTst")
+	if !check(BinomPiCDFFPri(7, 20)(0.2), 0.04305263) {
+		t.Error("posterior probability of H0")
+	}
+	if !BinomPiOneSidedTst(7, 20, 1, 1, 0.05, 0.2) || BinomPiOneSidedTst(7, 20, 1, 1, 0.01, 0.2) {
+		t.Error("one-sided")
+	}
+	for _, c := range []struct {
+		π0     float64
+		reject bool
+	}{{0.15, true}, {0.3, false}, {0.5, false}, {0.6, true}} {
+		if BinomPiTwoSidedTst(7, 20, 1, 1, 0.05, c.π0) != c.reject {
+			t.Error("two-sided", c.π0)
+		}
+	}
+
+	lo, hi := BinomPiCrIFPri(7, 20, 0.05)
+	if !check(lo, 0.1810716) || !check(hi, 0.5696755) {
+		t.Error("flat prior interval")
+	}
+	lo1, hi1 := BinomPiCrIJPri(7, 20, 0.05)
+	lo2, hi2 := BinomPiCrIBPri(7, 20, 0.5, 0.5, 0.05)
+	if lo1 != lo2 || hi1 != hi2 {
+		t.Error("Jeffreys prior interval")
+	}
+}
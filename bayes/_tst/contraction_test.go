@@ -0,0 +1,39 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestContraction(t *testing.T) {
+	fmt.Println("Testing ContractionReport")
+	if x := PoissonLambdaContraction(0, 0, 2, 0.5); x != 0 {
+		fmt.Println("failed: no data, Poisson ", x)
+		t.Error()
+	}
+	if x := NormMuContraction(0, 2, 1); x != 0 {
+		fmt.Println("failed: no data, Normal ", x)
+		t.Error()
+	}
+	// σPost = 1/sqrt(1 + n/4), n = 12 gives 1/2
+	if x := NormMuContraction(12, 2, 1); !check(x, 0.5) {
+		fmt.Println("failed: Normal ", x)
+		t.Error()
+	}
+
+	λ := 3.0
+	lastP, lastN := 0.0, 0.0
+	for _, n := range []int64{1, 10, 100, 1000, 10000} {
+		p := PoissonLambdaContraction(int64(λ*float64(n)), n, 2, 0.5)
+		q := NormMuContraction(int(n), 2, 1)
+		if p <= lastP || q <= lastN || p >= 1 || q >= 1 {
+			fmt.Println("failed: contraction does not increase toward 1 ", n, p, q)
+			t.Error()
+		}
+		lastP, lastN = p, q
+	}
+	if lastP < 0.95 || lastN < 0.95 {
+		fmt.Println("failed: ", lastP, lastN)
+		t.Error()
+	}
+}
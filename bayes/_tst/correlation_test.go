@@ -0,0 +1,51 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestCorrRhoRPri(t *testing.T) {
+	fmt.Println("Testing CorrRhoCrIRPri")
+	// interval shrinks with n
+	lastW := 3.0
+	for _, n := range []int{10, 30, 100, 1000} {
+		lo, hi := CorrRhoCrIRPri(0.5, n, 0.05)
+		if lo < -1 || hi > 1 || !(lo < 0.5 && 0.5 < hi) || hi-lo >= lastW {
+			fmt.Println("failed: ", n, lo, hi)
+			t.Error()
+		}
+		lastW = hi - lo
+	}
+
+	// asymmetric, wider toward 0, for large |r|
+	for _, r := range []float64{0.9, -0.9} {
+		lo, hi := CorrRhoCrIRPri(r, 20, 0.05)
+		if lo < -1 || hi > 1 {
+			fmt.Println("failed: outside [-1, 1] ", r, lo, hi)
+			t.Error()
+		}
+		toward0, away := r-lo, hi-r
+		if r < 0 {
+			toward0, away = hi-r, r-lo
+		}
+		if toward0 <= away {
+			fmt.Println("failed: not wider toward 0 ", r, lo, hi)
+			t.Error()
+		}
+	}
+
+	fmt.Println("Testing CorrRhoPDFRPri")
+	// integrates to 1 on (-1, 1)
+	pdf := CorrRhoPDFRPri(0.6, 25)
+	const m = 200000
+	h := 2.0 / m
+	s := 0.0
+	for i := 0; i < m; i++ {
+		s += pdf(-1+(float64(i)+0.5)*h) * h
+	}
+	if !check(s, 1) {
+		fmt.Println("failed: ", s)
+		t.Error()
+	}
+}
@@ -0,0 +1,137 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+	"testing"
+)
+
+// CoverageSimulation returns the share of reps simulated data sets whose interval contains trueParam,
+// the empirical frequentist coverage of the interval. generate draws one data set from the model with
+// the parameter set to trueParam. The source is seeded, so that a test fails or passes every time.
+func CoverageSimulation(trueParam float64, generate func(rng *rand.Rand) []float64, interval func(data []float64) (lo, hi float64), reps int) float64 {
+	rng := rand.New(rand.NewSource(20130131))
+	in := 0
+	for i := 0; i < reps; i++ {
+		lo, hi := interval(generate(rng))
+		if lo <= trueParam && trueParam <= hi {
+			in++
+		}
+	}
+	return float64(in) / float64(reps)
+}
+
+// coverageOK reports whether the coverage is within tol of 1-α, and prints it if not.
+func coverageOK(name string, coverage, α, tol float64) bool {
+	if abs(coverage-(1-α)) > tol {
+		fmt.Println("failed: coverage of ", name, coverage, "nominal ", 1-α)
+		return false
+	}
+	return true
+}
+
+// normalMean draws n observations of Normal(μ, σ), and returns their mean as a one-element data set.
+func normalMean(μ, σ float64, n int) func(rng *rand.Rand) []float64 {
+	return func(rng *rand.Rand) []float64 {
+		s := 0.0
+		for i := 0; i < n; i++ {
+			s += μ + σ*rng.NormFloat64()
+		}
+		return []float64{s / float64(n)}
+	}
+}
+
+func TestCoverageSimulation(t *testing.T) {
+	fmt.Println("Testing CoverageSimulation")
+	// the z interval of a Normal mean, known σ, covers with probability exactly 1-α
+	μ, σ, n, α := 3.0, 2.0, 10, 0.05
+	z := ZQtlFor(1 - α/2)
+	right := func(d []float64) (float64, float64) {
+		return d[0] - z*σ/sqrt(float64(n)), d[0] + z*σ/sqrt(float64(n))
+	}
+	if !coverageOK("z interval", CoverageSimulation(μ, normalMean(μ, σ, n), right, 4000), α, 0.015) {
+		t.Error()
+	}
+	// the same interval with the standard error σ/n is too short; the helper must see it
+	wrong := func(d []float64) (float64, float64) {
+		return d[0] - z*σ/float64(n), d[0] + z*σ/float64(n)
+	}
+	if c := CoverageSimulation(μ, normalMean(μ, σ, n), wrong, 4000); abs(c-(1-α)) < 0.1 {
+		t.Error()
+		fmt.Println("failed: a wrong interval passes ", c)
+	}
+}
+
+func TestCrICoverage(t *testing.T) {
+	fmt.Println("Testing coverage of the credible intervals")
+	const reps = 4000
+	α := 0.05
+
+	// Normal mean, known σ, diffuse Normal prior: the credible interval is the z interval
+	μ, σ, n := -1.5, 4.0, 12
+	c := CoverageSimulation(μ, normalMean(μ, σ, n), func(d []float64) (float64, float64) {
+		return NormMuCrINPriKnown(n, d[0], σ, 0, 1e6, α)
+	}, reps)
+	if !coverageOK("NormMuCrINPriKnown", c, α, 0.015) {
+		t.Error()
+	}
+	// an off-by-a-factor α is caught
+	c = CoverageSimulation(μ, normalMean(μ, σ, n), func(d []float64) (float64, float64) {
+		return NormMuCrINPriKnown(n, d[0], σ, 0, 1e6, 2*α)
+	}, reps)
+	if abs(c-(1-α)) < 0.03 {
+		t.Error()
+		fmt.Println("failed: coverage with 2α not caught ", c)
+	}
+
+	// Poisson rate, Jeffreys prior: approximately nominal when the expected total count is large
+	λ, m := 4.0, int64(25)
+	counts := func(rng *rand.Rand) []float64 {
+		sum := 0.0
+		for i := int64(0); i < m; i++ {
+			sum += float64(NewSamplerRand(rng).PoissonNext(λ))
+		}
+		return []float64{sum}
+	}
+	c = CoverageSimulation(λ, counts, func(d []float64) (float64, float64) {
+		return PoissonLambdaCrIGPri(int64(d[0]), m, 0.5, 0, α)
+	}, reps)
+	if !coverageOK("PoissonLambdaCrIGPri", c, α, 0.015) {
+		t.Error()
+	}
+
+	// Binomial proportion, Jeffreys prior: close to nominal (Brown, Cai and DasGupta 2001)
+	π, trials := 0.3, int64(100)
+	successes := func(rng *rand.Rand) []float64 {
+		k := 0.0
+		for i := int64(0); i < trials; i++ {
+			if rng.Float64() < π {
+				k++
+			}
+		}
+		return []float64{k}
+	}
+	c = CoverageSimulation(π, successes, func(d []float64) (float64, float64) {
+		return BinomPiCrIJPri(int64(d[0]), trials, α)
+	}, reps)
+	if !coverageOK("BinomPiCrIJPri", c, α, 0.02) {
+		t.Error()
+	}
+
+	// Exponential rate, Jeffreys prior: λ·sumT is Gamma(n, 1) whatever λ, so the coverage is exact
+	rate, k := 0.7, int64(8)
+	times := func(rng *rand.Rand) []float64 {
+		sum := 0.0
+		for i := int64(0); i < k; i++ {
+			sum += rng.ExpFloat64() / rate
+		}
+		return []float64{sum}
+	}
+	c = CoverageSimulation(rate, times, func(d []float64) (float64, float64) {
+		return ExpRateCrIGPri(d[0], k, 0, 0, α)
+	}, reps)
+	if !coverageOK("ExpRateCrIGPri", c, α, 0.015) {
+		t.Error()
+	}
+}
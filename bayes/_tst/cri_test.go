@@ -0,0 +1,48 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"testing"
+)
+
+func TestTailsFromConfidence(t *testing.T) {
+	fmt.Println("Testing TailsFromConfidence")
+	αLo, αHi := TailsFromConfidence(0.95)
+	if !check(αLo, 0.025) || !check(αHi, 0.975) {
+		fmt.Println("failed: ", αLo, αHi)
+		t.Error()
+	}
+
+	// every interval function with α = 1-0.95 returns the 2.5% and 97.5% quantiles
+	α := 1 - 0.95
+	same := func(name string, lo, hi, lo1, hi1 float64) {
+		if !check(lo, lo1) || !check(hi, hi1) {
+			fmt.Println("failed: ", name, lo, hi, lo1, hi1)
+			t.Error()
+		}
+	}
+
+	qtl := PoissonLambdaQtlGPri(30, 10, 2, 0.5)
+	lo, hi := PoissonLambdaCrIGPri(30, 10, 2, 0.5, α)
+	same("PoissonLambdaCrIGPri", lo, hi, qtl(0.025), qtl(0.975))
+	lo, hi = CrI(α, qtl)
+	same("CrI", lo, hi, qtl(0.025), qtl(0.975))
+
+	lo, hi = NormMuCrIFPriKnown(16, 5, 2, α)
+	same("NormMuCrIFPriKnown", lo, hi, NormalQtlFor(5, 0.5, 0.025), NormalQtlFor(5, 0.5, 0.975))
+
+	lo, hi = BinomPiCrIBP(1, 1, α, 20, 6)
+	same("BinomPiCrIBP", lo, hi, BetaQtlFor(7, 15, 0.025), BetaQtlFor(7, 15, 0.975))
+
+	lo, hi = BinomPiDiffCrI(0.1, 0.05, α)
+	same("BinomPiDiffCrI", lo, hi, NormalQtlFor(0.1, 0.05, 0.025), NormalQtlFor(0.1, 0.05, 0.975))
+
+	qtl = NormalMuDiffQtlNPriUn(12, 15, 10, 8, 2, 3, 0, 100, 0, 100, 0)
+	lo, hi = NormalMuDiffCrINPriUn(12, 15, 10, 8, 2, 3, 0, 100, 0, 100, α)(α)
+	same("NormalMuDiffCrINPriUn", lo, hi, qtl(0.025), qtl(0.975))
+	if lo >= hi {
+		fmt.Println("failed: NormalMuDiffCrINPriUn endpoints out of order ", lo, hi)
+		t.Error()
+	}
+}
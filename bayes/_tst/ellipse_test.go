@@ -0,0 +1,34 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestCredibleEllipse(t *testing.T) {
+	fmt.Println("Testing CredibleEllipse")
+	μ1, μ2, σ1, σ2 := 2.0, -1.0, 0.3, 1.5
+	conf := 0.95
+	// the extent of the region along each axis is the marginal credible interval
+	// at the level P(χ²(1) <= χ²(2, conf))
+	level := ChiSquareCDFAt(1, ChiSquareQtlFor(2, conf))
+	αLo, αHi := TailsFromConfidence(level)
+	for _, ρ := range []float64{0, 0.8, -0.5} {
+		pts := CredibleEllipse(μ1, μ2, σ1, σ2, ρ, conf)
+		lo1, hi1, lo2, hi2 := posInf, negInf, posInf, negInf
+		for _, p := range pts {
+			lo1, hi1 = math.Min(lo1, p[0]), math.Max(hi1, p[0])
+			lo2, hi2 = math.Min(lo2, p[1]), math.Max(hi2, p[1])
+		}
+		if !check(lo1, NormalQtlFor(μ1, σ1, αLo)) || !check(hi1, NormalQtlFor(μ1, σ1, αHi)) {
+			fmt.Println("failed: extent of 1st parameter ", ρ, lo1, hi1)
+			t.Error()
+		}
+		if !check(lo2, NormalQtlFor(μ2, σ2, αLo)) || !check(hi2, NormalQtlFor(μ2, σ2, αHi)) {
+			fmt.Println("failed: extent of 2nd parameter ", ρ, lo2, hi2)
+			t.Error()
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestErrorVariants(t *testing.T) {
+	fmt.Println("Testing error-returning variants")
+	μ, μPri := []float64{0, 1, 2}, []float64{0.2, 0.5, 0.3}
+
+	// good arguments: nil error, same result as the panicking function
+	pdf, err := PoissonLambdaPDFGPriE(12, 5, 2, 1)
+	if err != nil || pdf(2.2) != PoissonLambdaPDFGPri(12, 5, 2, 1)(2.2) {
+		fmt.Println("failed: PoissonLambdaPDFGPriE", err)
+		t.Error()
+	}
+	lo, hi, err := PoissonLambdaCrIGPriE(12, 5, 2, 1, 0.05)
+	lo0, hi0 := PoissonLambdaCrIGPri(12, 5, 2, 1, 0.05)
+	if err != nil || lo != lo0 || hi != hi0 {
+		fmt.Println("failed: PoissonLambdaCrIGPriE", err)
+		t.Error()
+	}
+	post, err := NormMuSinglePMFDPriE(1.3, 1, μ, μPri)
+	post0 := NormMuSinglePMFDPri(1.3, 1, μ, μPri)
+	if err != nil || len(post) != len(post0) || post[1] != post0[1] {
+		fmt.Println("failed: NormMuSinglePMFDPriE", err)
+		t.Error()
+	}
+	q, err := NormMuQtlFPriE(10, 1.3, 2, 0.9)
+	if err != nil || q != NormMuQtlFPri(10, 1.3, 2, 0.9) {
+		fmt.Println("failed: NormMuQtlFPriE", err)
+		t.Error()
+	}
+
+	// bad arguments: an error, and no panic
+	bad := map[string]func() error{
+		"PoissonLambdaPDFGPriE n":   func() error { _, err := PoissonLambdaPDFGPriE(12, 0, 2, 1); return err },
+		"PoissonLambdaCDFGPriE r":   func() error { _, err := PoissonLambdaCDFGPriE(12, 5, -2, 1); return err },
+		"PoissonLambdaQtlGPriE v":   func() error { _, err := PoissonLambdaQtlGPriE(12, 5, 2, -1); return err },
+		"PoissonLambdaCrIGPriE α":   func() error { _, _, err := PoissonLambdaCrIGPriE(12, 5, 2, 1, 1.5); return err },
+		"NormMuSinglePMFDPriE σ":    func() error { _, err := NormMuSinglePMFDPriE(1, -1, μ, μPri); return err },
+		"NormMuSinglePMFDPriE len":  func() error { _, err := NormMuSinglePMFDPriE(1, 1, μ, μPri[:2]); return err },
+		"NormMuSinglePMFDPriE mass": func() error { _, err := NormMuSinglePMFDPriE(1, 1, μ, []float64{0.5, -0.1, 0.6}); return err },
+		"NormMuSinglePMFDPriE zero": func() error { _, err := NormMuSinglePMFDPriE(1, 1, μ, []float64{0, 0, 0}); return err },
+		"NormMuPMFDPriE nObs":       func() error { _, err := NormMuPMFDPriE(-1, 1, 1, μ, μPri); return err },
+		"NormMuQtlFPriE nObs":       func() error { _, err := NormMuQtlFPriE(0, 1, 1, 0.5); return err },
+		"NormMuQtlNPriE σPri":       func() error { _, err := NormMuQtlNPriE(5, 1, 1, 0, 0, 0.5); return err },
+		"NormMuCrINPriKnownE σ":     func() error { _, _, err := NormMuCrINPriKnownE(5, 1, 0, 0, 1, 0.05); return err },
+		"BinomPiPDFBPriE k":         func() error { _, err := BinomPiPDFBPriE(-1, 10, 1, 1); return err },
+		"BinomPiCrIBPriE k":         func() error { _, _, err := BinomPiCrIBPriE(11, 10, 1, 1, 0.05); return err },
+	}
+	for name, f := range bad {
+		var err error
+		if panics(func() { err = f() }) || err == nil {
+			fmt.Println("failed: ", name, err)
+			t.Error()
+		}
+	}
+
+}
@@ -0,0 +1,52 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestExpRateGPri(t *testing.T) {
+	fmt.Println("Testing ExpRate functions, gamma prior")
+	sumT, n := 25.0, int64(10)
+	r, v := 2.0, 4.0
+
+	// conjugacy: prior × likelihood λ^n exp(-λ sumT), normalized numerically, is gamma(r+n, v+sumT)
+	unnorm := func(λ float64) float64 {
+		return GammaPDFAt(r, 1/v, λ) * math.Pow(λ, float64(n)) * math.Exp(-λ*sumT)
+	}
+	const h = 1e-4
+	z := 0.0
+	for λ := h / 2; λ < 3; λ += h {
+		z += unnorm(λ) * h
+	}
+	pdf := ExpRatePDFGPri(sumT, n, r, v)
+	for _, λ := range []float64{0.2, 0.4, 0.6} {
+		if !check(pdf(λ), unnorm(λ)/z) || pdf(λ) != GammaPDFAt(r+float64(n), 1/(v+sumT), λ) {
+			fmt.Println("failed: PDF ", λ, pdf(λ), unnorm(λ)/z)
+			t.Error()
+		}
+	}
+	if !check(ExpRatePostMean(sumT, n, r, v), 12./29) {
+		fmt.Println("failed: posterior mean ", ExpRatePostMean(sumT, n, r, v))
+		t.Error()
+	}
+	cdf, qtl := ExpRateCDFGPri(sumT, n, r, v), ExpRateQtlGPri(sumT, n, r, v)
+	if !check(cdf(qtl(0.3)), 0.3) {
+		fmt.Println("failed: CDF(Qtl(0.3)) ", cdf(qtl(0.3)))
+		t.Error()
+	}
+
+	// Jeffreys' prior: 2λ sumT ~ χ²(2n); χ²(0.025, 20) = 9.59078, χ²(0.975, 20) = 34.1696
+	lo, hi := ExpRateCrIGPri(sumT, n, 0, 0, 0.05)
+	if !check(lo, 9.59078/50) || !check(hi, 34.1696/50) {
+		fmt.Println("failed: CrI ", lo, hi)
+		t.Error()
+	}
+	if !panics(func() { ExpRatePDFGPri(-1, n, r, v) }) || !panics(func() { ExpRatePDFGPri(sumT, n, -1, v) }) ||
+		!panics(func() { ExpRatePDFGPri(0, 0, 0, 0) }) {
+		fmt.Println("failed: bad arguments accepted")
+		t.Error()
+	}
+}
@@ -0,0 +1,33 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFuseNormals(t *testing.T) {
+	fmt.Println("Testing FuseNormals")
+	// identical variances: simple mean, variance/n
+	m := []float64{9.8, 10.1, 10.4, 9.9}
+	v := []float64{0.25, 0.25, 0.25, 0.25}
+	μ, σ2 := FuseNormals(m, v)
+	if !check(μ, 10.05) || !check(σ2, 0.0625) {
+		fmt.Println("failed: ", μ, σ2)
+		t.Error()
+	}
+
+	// high-variance measurement contributes little
+	μ, σ2 = FuseNormals([]float64{10, 50}, []float64{1, 1e6})
+	if abs(μ-10) > 1e-4 || σ2 > 1 {
+		fmt.Println("failed: ", μ, σ2)
+		t.Error()
+	}
+
+	// agrees with the Normal posterior under a very wide prior
+	μ, σ2 = FuseNormals([]float64{3, 4, 8}, []float64{4, 4, 4})
+	y := NormMuPostMean(3, 5, 2, 0, 1e8)
+	if !check(μ, y) || !check(sqrt(σ2), NormMuPostStd(3, 2, 0, 1e8)) {
+		fmt.Println("failed: ", μ, y, σ2)
+		t.Error()
+	}
+}
@@ -0,0 +1,65 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGammaPrior(t *testing.T) {
+	fmt.Println("Testing GammaPrior")
+	var sumK, n int64 = 12, 5
+	r, v := 2.0, 1.0
+	post := GammaPrior{r, v}.Update(sumK, n)
+	pdf, pdf0 := post.PDF(), PoissonLambdaPDFGPri(sumK, n, r, v)
+	cdf, cdf0 := post.CDF(), PoissonLambdaCDFGPri(sumK, n, r, v)
+	for _, x := range []float64{0.5, 1.5, 2.3, 4} {
+		if pdf(x) != pdf0(x) || cdf(x) != cdf0(x) {
+			fmt.Println("failed: ", x, pdf(x), pdf0(x), cdf(x), cdf0(x))
+			t.Error()
+		}
+	}
+	lo, hi := post.CrI(0.05)
+	lo0, hi0 := PoissonLambdaCrIGPri(sumK, n, r, v, 0.05)
+	if lo != lo0 || hi != hi0 {
+		fmt.Println("failed: CrI ", lo, hi, lo0, hi0)
+		t.Error()
+	}
+	// gamma(14, 6): mean 14/6, variance 14/36
+	if !check(post.Mean(), 14./6) || !check(post.Var(), 14./36) {
+		fmt.Println("failed: moments ", post.Mean(), post.Var())
+		t.Error()
+	}
+	if post.Mean() != PoissonLambdaPostMean(sumK, n, r, v) {
+		fmt.Println("failed: PoissonLambdaPostMean ", PoissonLambdaPostMean(sumK, n, r, v))
+		t.Error()
+	}
+
+	// sequential updates equal one update with all the data
+	seq := GammaPrior{r, v}.Update(3, 2).Update(0, 1).Update(9, 2)
+	if seq != post {
+		fmt.Println("failed: sequential update ", seq, post)
+		t.Error()
+	}
+	if !panics(func() { GammaPrior{-1, 1}.PDF() }) || !panics(func() { GammaPrior{1, 1}.Update(-1, 2) }) {
+		fmt.Println("failed: bad arguments accepted")
+		t.Error()
+	}
+}
+
+func TestPriorString(t *testing.T) {
+	fmt.Println("Testing String of GammaPrior and NormalPrior")
+	if s := (GammaPrior{3.5, 2}).String(); s != "Gamma(shape=3.5, rate=2)" {
+		fmt.Println("failed: ", s)
+		t.Error()
+	}
+	if s := fmt.Sprint(NormalPrior{1.2, 0.3}); s != "Normal(μ=1.2, σ=0.3)" {
+		fmt.Println("failed: ", s)
+		t.Error()
+	}
+	post := GammaPrior{2, 1}.Update(12, 7)
+	var back GammaPrior
+	if _, err := fmt.Sscanf(post.String(), "Gamma(shape=%g, rate=%g)", &back.R, &back.V); err != nil || back != post {
+		fmt.Println("failed: round trip ", post, back, err)
+		t.Error()
+	}
+}
@@ -0,0 +1,88 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestHPDInterval(t *testing.T) {
+	fmt.Println("Testing HPDInterval")
+	α := 0.05
+
+	// symmetric posterior: HPD and equal tail intervals coincide
+	lo, hi := HPDInterval(NormalPDF(3, 2), NormalQtl(3, 2), α)
+	lo1, hi1 := CrI(α, NormalQtl(3, 2))
+	if !check(lo, lo1) || !check(hi, hi1) {
+		t.Error()
+		fmt.Println(lo, hi, lo1, hi1)
+	}
+
+	// Beta(30, 12) against R:Kruschke-2011 HDIofICDF
+	lo, hi = HPDInterval(BetaPDF(30, 12), BetaQtl(30, 12), α)
+	if !check(lo, 0.5780654) || !check(hi, 0.8448405) {
+		t.Error()
+		fmt.Println(lo, hi)
+	}
+
+	// decreasing density: the interval starts at zero
+	lo, hi = HPDInterval(GammaPDF(1, 2), GammaQtl(1, 2), α)
+	if lo != 0 || !check(hi, GammaQtlFor(1, 2, 1-α)) {
+		t.Error()
+		fmt.Println(lo, hi)
+	}
+
+	for _, a := range []float64{0, 1} {
+		if !panics(func() { HPDInterval(NormalPDF(0, 1), NormalQtl(0, 1), a) }) {
+			t.Error()
+			fmt.Println("no panic for α =", a)
+		}
+	}
+}
+
+func TestPoissonLambdaHPDGPri(t *testing.T) {
+	fmt.Println("Testing PoissonLambdaHPDGPri")
+	α := 0.1
+	for _, c := range []struct {
+		sumK, n int64
+		r, v    float64
+	}{
+		{3, 2, 1, 0},
+		{12, 4, 0.5, 0},
+		{40, 10, 6, 2},
+	} {
+		lo, hi := PoissonLambdaHPDGPri(c.sumK, c.n, c.r, c.v, α)
+		pdf := PoissonLambdaPDFGPri(c.sumK, c.n, c.r, c.v)
+		cdf := PoissonLambdaCDFGPri(c.sumK, c.n, c.r, c.v)
+		qtl := PoissonLambdaQtlGPri(c.sumK, c.n, c.r, c.v)
+
+		// equal density at the limits, and 1-α of the mass between them
+		if !check(pdf(lo), pdf(hi)) || math.Abs(cdf(hi)-cdf(lo)-(1-α)) > 1e-9 {
+			t.Error()
+			fmt.Println(c, lo, hi, pdf(lo), pdf(hi), cdf(hi)-cdf(lo))
+		}
+
+		// shorter than and to the left of the equal tail interval
+		elo, ehi := PoissonLambdaCrIGPri(c.sumK, c.n, c.r, c.v, α)
+		if !(hi-lo < ehi-elo && lo < elo && hi < ehi) {
+			t.Error()
+			fmt.Println(c, lo, hi, elo, ehi)
+		}
+
+		// the shortest of the intervals qtl(p), qtl(p+1-α) over a grid of p, as coda's HPDinterval
+		// does for a sorted sample
+		const m = 10000
+		blo, bhi := elo, ehi
+		for i := 0; i <= m; i++ {
+			p := α * float64(i) / m
+			if l, h := qtl(p), qtl(p+1-α); h-l < bhi-blo {
+				blo, bhi = l, h
+			}
+		}
+		if !check(lo, blo) || !check(hi, bhi) {
+			t.Error()
+			fmt.Println(c, lo, hi, blo, bhi)
+		}
+	}
+}
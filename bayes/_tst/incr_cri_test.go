@@ -0,0 +1,72 @@
+package bayes
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestIncrementalCrI(t *testing.T) {
+	fmt.Println("Testing IncrementalCrI")
+	rng := rand.New(rand.NewSource(1))
+	r, v, α := 2.0, 0.5, 0.05
+	c := NewPoissonLambdaIncrCrI(r, v, α)
+	sumK, n := iZero, iZero
+	for i := 0; i < 200; i++ {
+		k := int64(rng.Intn(10))
+		c.Update(k, 1)
+		sumK += k
+		n++
+		if i%20 == 0 {
+			lo, hi := c.CrI()
+			lo1, hi1 := PoissonLambdaCrIGPri(sumK, n, r, v, α)
+			if !check(lo, lo1) || !check(hi, hi1) {
+				fmt.Println("failed: Poisson ", i, lo, hi, lo1, hi1)
+				t.Error()
+			}
+		}
+	}
+
+	b := NewBinomPiIncrCrI(1, 1, α)
+	k, m := iZero, iZero
+	for i := 0; i < 200; i++ {
+		s := int64(rng.Intn(4))
+		b.Update(s, 3)
+		k += s
+		m += 3
+		if i%20 == 0 {
+			lo, hi := b.CrI()
+			qtl := BinomPiQtlBPri(k, m, 1, 1)
+			αLo, αHi := TailsFromConfidence(1 - α)
+			if !check(lo, qtl(αLo)) || !check(hi, qtl(αHi)) {
+				fmt.Println("failed: Binomial ", i, lo, hi, qtl(αLo), qtl(αHi))
+				t.Error()
+			}
+		}
+	}
+}
+
+// 10^5 updates, interval queried every 1000 updates
+func BenchmarkIncrementalCrI(bm *testing.B) {
+	for j := 0; j < bm.N; j++ {
+		c := NewPoissonLambdaIncrCrI(2, 0.5, 0.05)
+		for i := 0; i < 100000; i++ {
+			c.Update(int64(i%7), 1)
+			if i%1000 == 0 {
+				c.CrI()
+			}
+		}
+	}
+}
+
+// full recomputation from the accumulated data after every update
+func BenchmarkFullCrI(bm *testing.B) {
+	for j := 0; j < bm.N; j++ {
+		sumK, n := iZero, iZero
+		for i := 0; i < 100000; i++ {
+			sumK += int64(i % 7)
+			n++
+			PoissonLambdaCrIGPri(sumK, n, 2, 0.5, 0.05)
+		}
+	}
+}
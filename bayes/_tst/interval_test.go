@@ -0,0 +1,82 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHDI(t *testing.T) {
+	fmt.Println("Testing HDI, HDIMC")
+	α := 0.05
+	// Beta(2, 8) is skewed to the right: the HDI is shifted left of the equal tail interval, and narrower
+	lo, hi := HDI(BetaPDF(2, 8), 0, 1, α)
+	elo, ehi := CrI(α, BetaQtl(2, 8))
+	plo, phi := HPDInterval(BetaPDF(2, 8), BetaQtl(2, 8), α)
+	if math.Abs(lo-plo) > 1e-5 || math.Abs(hi-phi) > 1e-5 {
+		t.Error()
+		fmt.Println(lo, hi, plo, phi)
+	}
+	if !(hi-lo < ehi-elo && elo-lo > 0.01 && ehi-hi > 0.01) {
+		t.Error()
+		fmt.Println(lo, hi, elo, ehi)
+	}
+	if m := BetaCDFAt(2, 8, hi) - BetaCDFAt(2, 8, lo); math.Abs(m-(1-α)) > 1e-5 || !check(BetaPDFAt(2, 8, lo), BetaPDFAt(2, 8, hi)) {
+		t.Error()
+		fmt.Println(m, BetaPDFAt(2, 8, lo), BetaPDFAt(2, 8, hi))
+	}
+
+	// unnormalized density, and a density infinite at the lower end
+	lo, hi = HDI(func(x float64) float64 { return 42 * BetaPDFAt(2, 8, x) }, 0, 1, α)
+	if math.Abs(lo-plo) > 1e-5 || math.Abs(hi-phi) > 1e-5 {
+		t.Error()
+		fmt.Println(lo, hi, plo, phi)
+	}
+	lo, hi = HDI(GammaPDF(0.5, 1), 0, 40, 0.1)
+	if lo != 0 || math.Abs(hi-GammaQtlFor(0.5, 1, 0.9)) > 1e-4 {
+		t.Error()
+		fmt.Println(lo, hi, GammaQtlFor(0.5, 1, 0.9))
+	}
+
+	// the sample version, against the exact HDI
+	rng := rand.New(rand.NewSource(7))
+	smp := make([]float64, 200000)
+	for i := range smp {
+		x, y := rng.ExpFloat64(), 0.0
+		for j := 0; j < 8; j++ {
+			y += rng.ExpFloat64()
+		}
+		x += rng.ExpFloat64()
+		smp[i] = x / (x + y) // Beta(2, 8)
+	}
+	first := smp[0]
+	lo, hi = HDIMC(smp, α)
+	if math.Abs(lo-plo) > 0.005 || math.Abs(hi-phi) > 0.005 || smp[0] != first {
+		t.Error()
+		fmt.Println(lo, hi, plo, phi)
+	}
+	// for the same sample, never wider than the equal tail interval
+	elo, ehi = ECrI(smp, α)
+	if hi-lo > ehi-elo {
+		t.Error()
+		fmt.Println(lo, hi, elo, ehi)
+	}
+	if lo, hi := HDIMC([]float64{5, 1, 2, 3, 10}, 0.6); lo != 1 || hi != 3 {
+		t.Error()
+		fmt.Println(lo, hi)
+	}
+
+	for _, f := range []func(){
+		func() { HDI(BetaPDF(2, 8), 1, 0, α) },
+		func() { HDI(BetaPDF(2, 8), 0, 1, 0) },
+		func() { HDIMC([]float64{1}, α) },
+		func() { HDIMC(smp, 1) },
+	} {
+		if !panics(f) {
+			t.Error()
+			fmt.Println("no panic")
+		}
+	}
+}
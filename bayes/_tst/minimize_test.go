@@ -0,0 +1,45 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestMinimize1D(t *testing.T) {
+	fmt.Println("Testing Minimize1D")
+	tests := []struct {
+		name   string
+		f      func(float64) float64
+		lo, hi float64
+		x      float64
+	}{
+		{"parabola", func(x float64) float64 { return (x-2)*(x-2) + 1 }, -10, 10, 2},
+		{"cos", math.Cos, 0, 2 * math.Pi, math.Pi},
+		{"exp(x) - 2x", func(x float64) float64 { return math.Exp(x) - 2*x }, 0, 2, math.Ln2},
+		{"x log x", func(x float64) float64 { return x * math.Log(x) }, 0.01, 2, 1 / math.E},
+		{"Beta(5, 3) mode", func(x float64) float64 { return -BetaPDFAt(5, 3, x) }, 0, 1, 4.0 / 6},
+		{"far from zero", func(x float64) float64 { return math.Cosh(x - 1e4) }, 9990, 10020, 1e4},
+		{"at the bracket end", func(x float64) float64 { return x }, 1, 3, 1},
+	}
+	for _, tt := range tests {
+		evals := 0
+		f := func(x float64) float64 {
+			evals++
+			return tt.f(x)
+		}
+		x, fx := Minimize1D(f, tt.lo, tt.hi)
+		if math.Abs(x-tt.x) > 1e-7*math.Max(1, math.Abs(tt.x)) || fx != tt.f(x) || evals > 60 {
+			t.Error()
+			fmt.Println(tt.name, x, tt.x, fx, evals)
+		}
+	}
+
+	for _, b := range [][2]float64{{1, 1}, {2, 1}, {math.NaN(), 1}, {0, math.Inf(1)}} {
+		if !panics(func() { Minimize1D(math.Cos, b[0], b[1]) }) {
+			t.Error()
+			fmt.Println("no panic for bracket", b)
+		}
+	}
+}
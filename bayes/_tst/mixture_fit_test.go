@@ -0,0 +1,61 @@
+package bayes
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestFitGaussianMixture(t *testing.T) {
+	fmt.Println("Testing FitGaussianMixture with restarts")
+	// three well separated clusters of unequal size; a single EM run often
+	// puts two means into the large cluster and one between the small ones
+	rng := rand.New(rand.NewSource(3))
+	var data []float64
+	for _, c := range []struct {
+		μ, σ float64
+		n    int
+	}{{0, 1, 160}, {10, 1, 20}, {20, 1, 20}} {
+		for i := 0; i < c.n; i++ {
+			data = append(data, c.μ+c.σ*rng.NormFloat64())
+		}
+	}
+
+	best := negInf
+	var single []float64
+	for seed := int64(0); seed < 20; seed++ {
+		_, _, _, ll := FitGaussianMixture(data, 3, 1, rand.New(rand.NewSource(seed)))
+		single = append(single, ll)
+		if ll > best {
+			best = ll
+		}
+	}
+	poor := 0
+	for _, ll := range single {
+		if ll < best-1 {
+			poor++
+		}
+	}
+	fmt.Println("single runs at a poor local optimum:", poor, "of", len(single))
+	if poor == 0 {
+		t.Error("test data do not trap single EM runs")
+	}
+
+	for seed := int64(0); seed < 5; seed++ {
+		means, stds, weights, ll := FitGaussianMixture(data, 3, 10, rand.New(rand.NewSource(seed)))
+		if ll < best-1e-6*abs(best) {
+			t.Error()
+			fmt.Println(seed, ll, best)
+		}
+		for j, μ := range []float64{0, 10, 20} {
+			if abs(means[j]-μ) > 0.3 || abs(stds[j]-1) > 0.2 {
+				t.Error()
+				fmt.Println(seed, means, stds, weights)
+			}
+		}
+	}
+
+	if panicMsg(func() { FitGaussianMixture(data, 3, 0, nil) }) == "" {
+		t.Error("restarts = 0 accepted")
+	}
+}
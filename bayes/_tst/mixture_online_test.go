@@ -0,0 +1,89 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// batchEM runs iter steps of batch EM for a Normal mixture.
+func batchEM(data, means, stds, weights []float64, iter int) ([]float64, []float64, []float64) {
+	k := len(means)
+	m := append([]float64(nil), means...)
+	s := append([]float64(nil), stds...)
+	w := append([]float64(nil), weights...)
+	for it := 0; it < iter; it++ {
+		resp := MixtureResponsibilities(data, m, s, w)
+		for j := 0; j < k; j++ {
+			n, sx, sxx := 0.0, 0.0, 0.0
+			for i, x := range data {
+				n += resp[i][j]
+				sx += resp[i][j] * x
+				sxx += resp[i][j] * x * x
+			}
+			m[j] = sx / n
+			s[j] = math.Sqrt(sxx/n - m[j]*m[j])
+			w[j] = n / float64(len(data))
+		}
+	}
+	return m, s, w
+}
+
+func TestOnlineGMM(t *testing.T) {
+	fmt.Println("Testing OnlineGMM against batch EM")
+	rng := rand.New(rand.NewSource(1))
+	next := func() float64 {
+		if rng.Float64() < 0.3 {
+			return -2 + rng.NormFloat64()
+		}
+		return 3 + 1.5*rng.NormFloat64()
+	}
+	data := make([]float64, 5000)
+	for i := range data {
+		data[i] = next()
+	}
+	start := [][]float64{{-1, 1}, {1, 1}, {0.5, 0.5}}
+	bm, bs, bw := batchEM(data, start[0], start[1], start[2], 200)
+
+	g := NewOnlineGMM(start[0], start[1], start[2], nil)
+	for i := 0; i < 200000; i++ {
+		g.Add(next())
+	}
+	om, os, ow := g.Means(), g.Stds(), g.Weights()
+	for j := range om {
+		if math.Abs(om[j]-bm[j]) > 0.1 || math.Abs(os[j]-bs[j]) > 0.1 || math.Abs(ow[j]-bw[j]) > 0.03 {
+			t.Error()
+			fmt.Println(j, om[j], bm[j], os[j], bs[j], ow[j], bw[j])
+		}
+	}
+	if g.N() != 200000 {
+		t.Error("N", g.N())
+	}
+
+	if panicMsg(func() { OnlineGMMRate(0.4, 1) }) == "" {
+		t.Error("κ <= 0.5 accepted")
+	}
+
+	// a component of zero weight keeps its starting values
+	g = NewOnlineGMM([]float64{0, 5}, []float64{1, 2}, []float64{1, 0}, nil)
+	if m, s := g.Means(), g.Stds(); m[1] != 5 || s[1] != 2 {
+		t.Error("zero weight start", m, s)
+	}
+	if msg := panicMsg(func() { g.Add(0.3) }); msg != "" {
+		t.Error("zero weight component:", msg)
+	}
+	// a component of zero weight recovers when observations come from near its mean
+	g = NewOnlineGMM([]float64{0, 10}, []float64{1, 1}, []float64{1, 0}, nil)
+	rng = rand.New(rand.NewSource(2))
+	for i := 0; i < 20000; i++ {
+		if rng.Float64() < 0.4 {
+			g.Add(10 + rng.NormFloat64())
+		} else {
+			g.Add(rng.NormFloat64())
+		}
+	}
+	if w, m := g.Weights(), g.Means(); math.Abs(w[1]-0.4) > 0.05 || math.Abs(m[1]-10) > 0.2 {
+		t.Error("zero weight component does not recover", w, m)
+	}
+}
@@ -0,0 +1,45 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestMixtureResponsibilities(t *testing.T) {
+	fmt.Println("Testing MixtureResponsibilities")
+	means := []float64{-5, 0, 8}
+	stds := []float64{1, 0.5, 2}
+	weights := []float64{0.3, 0.2, 0.5}
+	data := []float64{-5.2, -4.1, 0.1, -0.3, 7.5, 9.9, 2.6, -60, 60}
+	resp := MixtureResponsibilities(data, means, stds, weights)
+	for i, r := range resp {
+		s := 0.0
+		for _, p := range r {
+			s += p
+		}
+		if math.Abs(s-1) > 1e-12 {
+			fmt.Println("failed: row does not sum to 1 ", data[i], r)
+			t.Error()
+		}
+	}
+	// points near a mean are assigned to its component
+	for i, j := range []int{0, 0, 1, 1, 2, 2} {
+		if resp[i][j] < 0.99 {
+			fmt.Println("failed: assignment ", data[i], resp[i])
+			t.Error()
+		}
+	}
+	// far in the tails, where all densities underflow, to the widest component
+	if resp[7][2] < 0.99 || resp[8][2] < 0.99 {
+		fmt.Println("failed: tails ", resp[7], resp[8])
+		t.Error()
+	}
+
+	// a single component takes everything
+	resp = MixtureResponsibilities([]float64{1, 2}, []float64{0}, []float64{1}, []float64{3})
+	if resp[0][0] != 1 || resp[1][0] != 1 {
+		fmt.Println("failed: single component ", resp)
+		t.Error()
+	}
+}
@@ -0,0 +1,99 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// betaPost returns a sampler of the Beta(a, b) posterior.
+func betaPost(a, b float64) func(rng *rand.Rand) float64 {
+	return func(rng *rand.Rand) float64 { return NewSamplerRand(rng).BetaNext(a, b) }
+}
+
+func TestProbabilityBest(t *testing.T) {
+	fmt.Println("Testing ProbabilityBest")
+	rng := rand.New(rand.NewSource(1))
+
+	// one clearly superior group: 90/100 successes vs 50/100 and 40/100
+	posts := []func(*rand.Rand) float64{betaPost(51, 51), betaPost(91, 11), betaPost(41, 61)}
+	p := ProbabilityBest(posts, 10000, rng)
+	if p[1] < 0.999 || math.Abs(p[0]+p[1]+p[2]-1) > 1e-12 {
+		fmt.Println("failed: superior group ", p)
+		t.Error()
+	}
+
+	// identical groups split the probability evenly
+	posts = []func(*rand.Rand) float64{betaPost(20, 30), betaPost(20, 30), betaPost(20, 30), betaPost(20, 30)}
+	p = ProbabilityBest(posts, 40000, rng)
+	for j := range p {
+		if math.Abs(p[j]-0.25) > 0.015 {
+			fmt.Println("failed: identical groups ", p)
+			t.Error()
+			break
+		}
+	}
+
+	// exact ties are shared
+	same := func(*rand.Rand) float64 { return 1 }
+	p = ProbabilityBest([]func(*rand.Rand) float64{same, same}, 10, rng)
+	if p[0] != 0.5 || p[1] != 0.5 {
+		fmt.Println("failed: ties ", p)
+		t.Error()
+	}
+}
+
+func TestThompsonAllocation(t *testing.T) {
+	fmt.Println("Testing ThompsonAllocation")
+	rng := rand.New(rand.NewSource(2))
+	posts := []func(*rand.Rand) float64{betaPost(30, 20), betaPost(28, 22), betaPost(25, 25), betaPost(5, 95)}
+	p := ProbabilityBest(posts, 40000, rng)
+
+	const n = 40000
+	freq := make([]float64, len(posts))
+	for i := 0; i < n; i++ {
+		freq[ThompsonAllocation(posts, rng)]++
+	}
+	for j := range freq {
+		freq[j] /= n
+		if math.Abs(freq[j]-p[j]) > 0.015 {
+			fmt.Println("failed: allocation frequencies ", freq, p)
+			t.Error()
+			break
+		}
+	}
+	// the 4th arm, with posterior mean 0.05, is never chosen
+	if freq[3] != 0 {
+		fmt.Println("failed: inferior arm chosen ", freq[3])
+		t.Error()
+	}
+}
+
+func TestExpectedLoss(t *testing.T) {
+	fmt.Println("Testing ExpectedLoss")
+	rng := rand.New(rand.NewSource(3))
+	posts := []func(*rand.Rand) float64{betaPost(51, 51), betaPost(91, 11), betaPost(41, 61)}
+	if l := ExpectedLoss(posts, 1, 20000, rng); l > 1e-4 {
+		fmt.Println("failed: loss of the best arm ", l)
+		t.Error()
+	}
+	// the loss of a clearly inferior arm is about the difference of the posterior means, 0.892 - 0.402
+	if l := ExpectedLoss(posts, 2, 20000, rng); math.Abs(l-0.49) > 0.01 {
+		fmt.Println("failed: loss of an inferior arm ", l)
+		t.Error()
+	}
+
+	// the loss of the best arm shrinks as data accumulate
+	last := posInf
+	for _, n := range []float64{10, 100, 1000} {
+		posts = []func(*rand.Rand) float64{betaPost(1+0.5*n, 1+0.5*n), betaPost(1+0.55*n, 1+0.45*n)}
+		l := ExpectedLoss(posts, 1, 20000, rng)
+		if l >= last {
+			fmt.Println("failed: loss does not decrease ", n, l, last)
+			t.Error()
+		}
+		last = l
+	}
+}
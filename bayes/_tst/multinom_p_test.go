@@ -0,0 +1,101 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMultinomPiDirPri(t *testing.T) {
+	fmt.Println("Testing MultinomPiPostParams, MultinomPiPostMean and MultinomPiCrIDirPri")
+	counts := []int64{12, 5, 3}
+	conc := []float64{1, 1, 1} // uniform prior
+	post := MultinomPiPostParams(counts, conc)
+	want := []float64{13, 6, 4}
+	mean := MultinomPiPostMean(counts, conc)
+	for i := range want {
+		if post[i] != want[i] || !check(mean[i], want[i]/23) {
+			t.Error()
+			fmt.Println(i, post, mean)
+		}
+	}
+	if conc[0] != 1 || conc[1] != 1 || conc[2] != 1 {
+		t.Error()
+		fmt.Println("failed: prior modified ", conc)
+	}
+
+	// the marginal of p[0] is Beta(13, 10), the posterior of 12 successes in 20 trials with a Beta(1, 2) prior
+	const α = 0.05
+	lo, hi := MultinomPiCrIDirPri(counts, conc, 0, α)
+	blo, bhi := BinomPiCrIBPri(12, 20, 1, 2, α)
+	if !check(lo, blo) || !check(hi, bhi) {
+		t.Error()
+		fmt.Println(lo, hi, blo, bhi)
+	}
+
+	// posterior draws: means, and the share of each p[i] inside its credible interval
+	const n = 20000
+	smp := NewSamplerRand(rand.New(rand.NewSource(20130131)))
+	var sum [3]float64
+	var in [3]int
+	var cri [3][2]float64
+	for i := range cri {
+		cri[i][0], cri[i][1] = MultinomPiCrIDirPri(counts, conc, i, α)
+	}
+	for j := 0; j < n; j++ {
+		p := smp.DirichletNext(post)
+		if !check(p[0]+p[1]+p[2], 1) {
+			t.Error()
+			fmt.Println("failed: not on the simplex ", p)
+			break
+		}
+		for i := range p {
+			sum[i] += p[i]
+			if p[i] >= cri[i][0] && p[i] <= cri[i][1] {
+				in[i]++
+			}
+		}
+	}
+	for i := range sum {
+		sd := math.Sqrt(DirichletVar(post)[i])
+		if math.Abs(sum[i]/n-mean[i]) > 4*sd/math.Sqrt(n) || math.Abs(float64(in[i])/n-(1-α)) > 0.01 {
+			t.Error()
+			fmt.Println(i, sum[i]/n, mean[i], float64(in[i])/n)
+		}
+	}
+
+	for i, f := range []func(){
+		func() { MultinomPiPostParams([]int64{1, 2}, []float64{1, 1, 1}) },
+		func() { MultinomPiPostParams([]int64{1, -2, 3}, conc) },
+		func() { MultinomPiPostParams([]int64{1, 2, 3}, []float64{1, -1, 1}) },
+		func() { MultinomPiPostParams([]int64{4, 0, 3}, []float64{0, 0, 0}) },
+		func() { MultinomPiPostParams([]int64{4}, []float64{1}) },
+		func() { MultinomPiCrIDirPri(counts, conc, 3, α) },
+	} {
+		if !panics(f) {
+			t.Error()
+			fmt.Println("failed: no panic for bad arguments ", i)
+		}
+	}
+}
+
+func TestMultinomPiPDFDirPri(t *testing.T) {
+	fmt.Println("Testing MultinomPiPDFDirPri")
+	x := []float64{0.5, 0.3, 0.2}
+	α := []float64{1, 1, 1}
+	if p := MultinomPiPDFDirPri(α, x); !check(p, DirichletPDFAt([]float64{1.5, 1.3, 1.2}, x)) {
+		t.Error()
+		fmt.Println(p)
+	}
+	if α[0] != 1 || α[1] != 1 || α[2] != 1 {
+		t.Error()
+		fmt.Println("failed: prior modified ", α)
+	}
+	// nil is the Haldane prior
+	if p, q := MultinomPiPDFDirPri(nil, x), MultinomPiPDFDirPri([]float64{0, 0, 0}, x); !check(p, q) {
+		t.Error()
+		fmt.Println(p, q)
+	}
+}
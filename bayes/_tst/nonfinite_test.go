@@ -0,0 +1,40 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// panics reports whether f panics.
+func panics(f func()) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = true
+		}
+	}()
+	f()
+	return
+}
+
+func TestNonFiniteInput(t *testing.T) {
+	fmt.Println("Testing rejection of non-finite input")
+	nan, inf := math.NaN(), math.Inf(1)
+	cases := map[string]func(){
+		"PropDisc":             func() { PropDisc([]float64{0.2, nan, 0.6}, []float64{0.3, 0.3, 0.4}, 3, 5) },
+		"PropDisc prior":       func() { PropDisc([]float64{0.2, 0.4, 0.6}, []float64{0.3, inf, 0.4}, 3, 5) },
+		"DiscHPI":              func() { DiscHPI([]float64{1, 2, 3}, []float64{0.2, nan, 0.3}, 0.9) },
+		"LogPoissGamma":        func() { LogPoissGamma([]float64{0, 1}, []float64{2, inf}, 1, 1) },
+		"CorrRhoCrIRPri":       func() { CorrRhoCrIRPri(nan, 20, 0.05) },
+		"EmpiricalQuantile":    func() { EmpiricalQuantile([]float64{1, nan, 3}, 0.5) },
+		"EmpiricalQuantile p":  func() { EmpiricalQuantile([]float64{1, 2, 3}, nan) },
+		"SummarizeSamples":     func() { SummarizeSamples([]float64{1, 2, inf, 4}, 0.05) },
+		"PoissonLambdaCrIGPri": func() { PoissonLambdaCrIGPri(10, 5, 1, 1, nan) },
+	}
+	for name, f := range cases {
+		if !panics(f) {
+			fmt.Println("failed: no panic in ", name)
+			t.Error()
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNormalMuDiffCrIFPriUnScale(t *testing.T) {
+	fmt.Println("Testing NormalMuDiffCrIFPriUn: scale of the interval")
+	// with nearly flat priors and large samples the interval is ȳ1-ȳ2 ± z(0.975)·sqrt(s1²/n1+s2²/n2);
+	// it used to be scaled by sqrt(s1²+s2²), the spread of single observations, not of the means
+	α := 0.05
+	n1, n2 := 20000, 30000
+	ȳ1, ȳ2, s1, s2 := 10.3, 8.9, 1.8, 3.1
+	lo, hi := NormalMuDiffCrIFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6, α)(α)
+	se := math.Sqrt(s1*s1/float64(n1) + s2*s2/float64(n2))
+	if !check((hi-lo)/2, 1.959963984540054*se) || !check((lo+hi)/2, ȳ1-ȳ2) {
+		t.Error()
+		fmt.Println(lo, hi, ȳ1-ȳ2, 1.959963984540054*se)
+	}
+}
+
+func TestNormalMuDiffCrIFPriUnLocation(t *testing.T) {
+	fmt.Println("Testing NormalMuDiffCrIFPriUn: location of the interval")
+	// flat priors: the interval is centered at ȳ1-ȳ2 whatever prior parameters are passed
+	α := 0.05
+	n1, n2 := 8, 11
+	ȳ1, ȳ2, s1, s2 := 10.3, 8.9, 1.8, 3.1
+	lo, hi := NormalMuDiffCrIFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 0.01, 50, 0.01, α)(α)
+	if !check((lo+hi)/2, ȳ1-ȳ2) {
+		t.Error()
+		fmt.Println(lo, hi, ȳ1-ȳ2)
+	}
+}
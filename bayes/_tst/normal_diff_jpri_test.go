@@ -0,0 +1,104 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestNormalMuDiffCrIJPri(t *testing.T) {
+	fmt.Println("Testing NormalMuDiffCrIJPriUn")
+	α := 0.05
+
+	// matches the Welch t-interval
+	n1, n2 := 12, 20
+	ȳ1, ȳ2, s1, s2 := 10.3, 8.9, 1.8, 3.1
+	v1, v2 := s1*s1/float64(n1), s2*s2/float64(n2)
+	ν := (v1 + v2) * (v1 + v2) / (v1*v1/float64(n1-1) + v2*v2/float64(n2-1))
+	h := StudentsTQtlFor(ν, 0.975) * math.Sqrt(v1+v2)
+	lo, hi := NormalMuDiffCrIJPriUn(n1, n2, ȳ1, ȳ2, s1, s2, α)
+	if !check(lo, ȳ1-ȳ2-h) || !check(hi, ȳ1-ȳ2+h) {
+		fmt.Println("failed: Welch ", lo, hi, ȳ1-ȳ2-h, ȳ1-ȳ2+h)
+		t.Error()
+	}
+
+	// with large samples, converges to the flat-prior and known-variance intervals
+	n1, n2 = 20000, 30000
+	lo, hi = NormalMuDiffCrIJPriUn(n1, n2, ȳ1, ȳ2, s1, s2, α)
+	lo1, hi1 := NormalMuDiffCrIFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6, α)(α)
+	lo2, hi2 := NormalMuDiffCrIJPriKn(n1, n2, ȳ1, ȳ2, s1, s2, α)
+	if !check(lo, lo1) || !check(hi, hi1) || !check(lo, lo2) || !check(hi, hi2) {
+		fmt.Println("failed: large samples ", lo, hi, lo1, hi1, lo2, hi2)
+		t.Error()
+	}
+}
+
+func TestNormalMuDiffOneSidedUn(t *testing.T) {
+	fmt.Println("Testing one-sided Behrens-Fisher bounds")
+	n1, n2 := 12, 15
+	ȳ1, ȳ2, s1, s2 := 10.0, 8.0, 2.0, 3.0
+	for _, α := range []float64{0.01, 0.05, 0.2} {
+		// the one-sided bound at α/2 is the end of the two-sided interval at α
+		lo, hi := NormalMuDiffCrINPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 9, 2, 7, 3, α)(α)
+		lo1 := NormalMuDiffLowerNPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 9, 2, 7, 3)(α / 2)
+		hi1 := NormalMuDiffUpperNPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 9, 2, 7, 3)(α / 2)
+		if !check(lo, lo1) || !check(hi, hi1) {
+			t.Error()
+			fmt.Println("normal priors", α, lo, lo1, hi, hi1)
+		}
+		lo, hi = NormalMuDiffCrIFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6, α)(α)
+		lo1 = NormalMuDiffLowerFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6)(α / 2)
+		hi1 = NormalMuDiffUpperFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6)(α / 2)
+		if !check(lo, lo1) || !check(hi, hi1) {
+			t.Error()
+			fmt.Println("flat priors", α, lo, lo1, hi, hi1)
+		}
+		// a one-sided bound at α is tighter than the two-sided end
+		if lo2 := NormalMuDiffLowerFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6)(α); !(lo2 > lo && lo2 < ȳ1-ȳ2) {
+			t.Error()
+			fmt.Println("one-sided", α, lo2, lo)
+		}
+	}
+	// flat priors ignore the prior parameters: a strong prior does not move the bounds
+	lo, hi := NormalMuDiffCrIFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6, 0.05)(0.05)
+	lo1, hi1 := NormalMuDiffCrIFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 0.01, 20, 0.01, 0.05)(0.05)
+	lo2 := NormalMuDiffLowerFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 0.01, 20, 0.01)(0.025)
+	hi2 := NormalMuDiffUpperFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 0.01, 20, 0.01)(0.025)
+	if lo1 != lo || hi1 != hi || !check(lo2, lo) || !check(hi2, hi) {
+		t.Error()
+		fmt.Println("strong prior", lo, hi, lo1, hi1, lo2, hi2)
+	}
+	if !panics(func() { NormalMuDiffLowerFPriUn(n1, n2, ȳ1, ȳ2, s1, s2, 0, 1e6, 0, 1e6)(1) }) {
+		t.Error()
+		fmt.Println("no panic for α = 1")
+	}
+}
+
+func TestNormalMuDiffEquivTstJPri(t *testing.T) {
+	fmt.Println("Testing NormalMuDiffEquivTstJPri")
+	n1, n2 := 30, 30
+	ȳ1, ȳ2, s1, s2 := 10.1, 10.0, 0.5, 0.6
+	// the p-value is the larger one-sided Welch p-value
+	v1, v2 := s1*s1/float64(n1), s2*s2/float64(n2)
+	ν := (v1 + v2) * (v1 + v2) / (v1*v1/float64(n1-1) + v2*v2/float64(n2-1))
+	se := math.Sqrt(v1 + v2)
+	want := math.Max(1-StudentsTCDFAt(ν, (0.1+0.3)/se), StudentsTCDFAt(ν, (0.1-0.5)/se))
+	eq, p := NormalMuDiffEquivTstJPri(n1, n2, ȳ1, ȳ2, s1, s2, -0.3, 0.5, 0.05, false)
+	if !eq || !check(p, want) {
+		t.Error()
+		fmt.Println(eq, p, want)
+	}
+	// for [-δ, δ], equivalent exactly when the (1-2α) credible interval is inside
+	for _, δ := range []float64{0.2, 0.3, 0.5} {
+		lo, hi := NormalMuDiffCrIJPriEqVar(n1, n2, ȳ1, ȳ2, s1, s2, 0.1)
+		if eq, _ := NormalMuDiffEquivTstJPri(n1, n2, ȳ1, ȳ2, s1, s2, -δ, δ, 0.05, true); eq != (lo > -δ && hi < δ) {
+			t.Error()
+			fmt.Println(δ, eq, lo, hi)
+		}
+	}
+	if !panics(func() { NormalMuDiffEquivTstJPri(n1, n2, ȳ1, ȳ2, s1, s2, 0.5, -0.5, 0.05, false) }) {
+		t.Error()
+		fmt.Println("failed: no panic for reversed bounds")
+	}
+}
@@ -0,0 +1,88 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestNormalTwoSampleReport(t *testing.T) {
+	fmt.Println("Testing NormalTwoSampleReport")
+	const α, δ = 0.05, 2.0
+	n1, n2 := 12, 20
+	ȳ1, ȳ2, s1, s2 := 10.3, 8.9, 1.8, 3.1
+	sp := math.Sqrt((11*s1*s1 + 19*s2*s2) / 30)
+
+	for _, equalVar := range []bool{true, false} {
+		rep := NormalTwoSampleReport(n1, ȳ1, s1, n2, ȳ2, s2, α, δ, equalVar)
+
+		// each field is what the dedicated function returns
+		var lo, hi float64
+		if equalVar {
+			lo, hi = NormalMuDiffCrIJPriEqVar(n1, n2, ȳ1, ȳ2, s1, s2, α)
+		} else {
+			lo, hi = NormalMuDiffCrIJPriUn(n1, n2, ȳ1, ȳ2, s1, s2, α)
+		}
+		d, dlo, dhi := NormalCohenD(n1, n2, ȳ1, ȳ2, s1, s2, α)
+		eq, _ := NormalMuDiffEquivTstJPri(n1, n2, ȳ1, ȳ2, s1, s2, -δ, δ, α, equalVar)
+		if rep.EqualVar != equalVar || rep.CrILo != lo || rep.CrIHi != hi ||
+			rep.PGreater != NormalMuDiffProbGreaterJPri(n1, n2, ȳ1, ȳ2, s1, s2, equalVar) ||
+			rep.CohenD != d || rep.CohenDLo != dlo || rep.CohenDHi != dhi || rep.ROPE != δ ||
+			rep.PInROPE != NormalMuDiffROPEProbJPri(n1, n2, ȳ1, ȳ2, s1, s2, δ, equalVar) ||
+			rep.Equivalent != eq {
+			t.Error()
+			fmt.Println(equalVar, rep)
+		}
+
+		// the posterior: pooled t with n1+n2-2 df, or Welch's t
+		scale, ν := sp*math.Sqrt(1.0/12+1.0/20), 30.0
+		if !equalVar {
+			v1, v2 := s1*s1/12, s2*s2/20
+			scale, ν = math.Sqrt(v1+v2), (v1+v2)*(v1+v2)/(v1*v1/11+v2*v2/19)
+		}
+		h := StudentsTQtlFor(ν, 0.975) * scale
+		z := (ȳ1 - ȳ2) / scale
+		if !check(rep.Mean, ȳ1-ȳ2) || !check(rep.Scale, scale) || !check(rep.Df, ν) ||
+			!check(rep.CrILo, ȳ1-ȳ2-h) || !check(rep.CrIHi, ȳ1-ȳ2+h) || !check(rep.PGreater, StudentsTCDFAt(ν, z)) ||
+			!check(rep.PInROPE, StudentsTCDFAt(ν, (δ-(ȳ1-ȳ2))/scale)-StudentsTCDFAt(ν, (-δ-(ȳ1-ȳ2))/scale)) {
+			t.Error()
+			fmt.Println(equalVar, rep, scale, ν)
+		}
+		// 0 is inside the 95% interval exactly when P(μ1 > μ2) < 0.975; the interval reaches beyond δ, so not equivalent
+		if (rep.CrILo < 0) != (rep.PGreater < 0.975) || rep.Equivalent {
+			t.Error()
+			fmt.Println(equalVar, rep)
+		}
+		if !check(rep.CohenD, 1.4/sp) || !check(rep.CohenDHi-rep.CohenD, rep.CohenD-rep.CohenDLo) {
+			t.Error()
+			fmt.Println(equalVar, rep.CohenD, rep.CohenDLo, rep.CohenDHi, 1.4/sp)
+		}
+	}
+
+	// large samples with nearly equal means are equivalent within ±0.5; the (1-2α) interval is inside, the (1-α) one too
+	rep := NormalTwoSampleReport(400, 5.05, 1, 400, 5, 1.1, α, 0.5, false)
+	if !rep.Equivalent || !(rep.CrILo > -0.5 && rep.CrIHi < 0.5) || !(rep.PInROPE > 0.99) {
+		t.Error()
+		fmt.Println("failed: equivalence ", rep)
+	}
+	// equal samples: d = 0, P(μ1 > μ2) = 1/2, and both routes agree
+	r1 := NormalTwoSampleReport(15, 3, 2, 15, 3, 2, α, δ, true)
+	r2 := NormalTwoSampleReport(15, 3, 2, 15, 3, 2, α, δ, false)
+	if r1.CohenD != 0 || !check(r1.PGreater, 0.5) || !check(r1.CrIHi, r2.CrIHi) || !check(r1.Df, r2.Df) {
+		t.Error()
+		fmt.Println("failed: equal samples ", r1, r2)
+	}
+
+	for i, f := range []func(){
+		func() { NormalTwoSampleReport(1, ȳ1, s1, n2, ȳ2, s2, α, δ, true) },
+		func() { NormalTwoSampleReport(n1, ȳ1, 0, n2, ȳ2, s2, α, δ, false) },
+		func() { NormalTwoSampleReport(n1, ȳ1, s1, n2, ȳ2, s2, 0.5, δ, true) },
+		func() { NormalTwoSampleReport(n1, ȳ1, s1, n2, ȳ2, s2, α, 0, true) },
+	} {
+		if !panics(f) {
+			t.Error()
+			fmt.Println("failed: no panic for bad arguments ", i)
+		}
+	}
+}
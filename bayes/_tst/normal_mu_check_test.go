@@ -0,0 +1,58 @@
+package bayes
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// panicMsg returns the panic message of f, or "" if f does not panic.
+func panicMsg(f func()) (msg string) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg = fmt.Sprint(r)
+		}
+	}()
+	f()
+	return
+}
+
+func TestNormMuSigmaCheck(t *testing.T) {
+	fmt.Println("Testing σ validation of NormMu functions")
+	μ, μPri := []float64{0, 1}, []float64{0.5, 0.5}
+	// functions called with a bad population σ
+	badσ := map[string]func(){
+		"NormMuSinglePMFDPri": func() { NormMuSinglePMFDPri(1, 0, μ, μPri) },
+		"NormMuPMFDPri":       func() { NormMuPMFDPri(5, 1, -1, μ, μPri) },
+		"NormMuPostMean":      func() { NormMuPostMean(5, 1, 0, 0, 1) },
+		"NormMuPostStd":       func() { NormMuPostStd(5, -2, 0, 1) },
+		"NormMuSingleQtlFPri": func() { NormMuSingleQtlFPri(1, 0, 0.5) },
+		"NormMuQtlFPri":       func() { NormMuQtlFPri(5, 1, 0, 0.5) },
+		"NormMuSingleQtlNPri": func() { NormMuSingleQtlNPri(1, 0, 0, 1, 0.5) },
+		"NormMuQtlNPri":       func() { NormMuQtlNPri(5, 1, 0, 0, 1, 0.5) },
+		"NormMuCrINPriKnown":  func() { NormMuCrINPriKnown(5, 1, 0, 0, 1, 0.05) },
+		"NormMuCrIFPriKnown":  func() { NormMuCrIFPriKnown(5, 1, 0, 0.05) },
+		"NormMuContraction":   func() { NormMuContraction(5, 0, 1) },
+	}
+	// functions called with a valid σ but a bad prior σPri
+	badσPri := map[string]func(){
+		"NormMuPostMean":      func() { NormMuPostMean(5, 1, 2, 0, 0) },
+		"NormMuPostStd":       func() { NormMuPostStd(5, 2, 0, -1) },
+		"NormMuSingleQtlNPri": func() { NormMuSingleQtlNPri(1, 2, 0, 0, 0.5) },
+		"NormMuQtlNPri":       func() { NormMuQtlNPri(5, 1, 2, 0, 0, 0.5) },
+		"NormMuCrINPriKnown":  func() { NormMuCrINPriKnown(5, 1, 2, 0, 0, 0.05) },
+		"NormMuContraction":   func() { NormMuContraction(5, 2, 0) },
+	}
+	for name, f := range badσ {
+		if msg := panicMsg(f); !strings.HasPrefix(msg, "Population standard deviation") {
+			fmt.Println("failed: ", name, msg)
+			t.Error()
+		}
+	}
+	for name, f := range badσPri {
+		if msg := panicMsg(f); !strings.HasPrefix(msg, "Prior standard deviation") {
+			fmt.Println("failed: ", name, msg)
+			t.Error()
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNormMuPred(t *testing.T) {
+	fmt.Println("Testing NormMuPredPDF, NormMuPredCDF, NormMuPredQtl")
+	const (
+		ȳ, σ = 10.2, 2.0
+		μPri = 8.0
+		α    = 0.05
+		nObs = 5
+		σPri = 3.0
+	)
+	// predictive is Normal with mean μPost and variance σPost² + σ²
+	μPost := NormMuPostMean(nObs, ȳ, σ, μPri, σPri)
+	σPost := NormMuPostStd(nObs, σ, μPri, σPri)
+	sd := math.Sqrt(σPost*σPost + σ*σ)
+	pdf := NormMuPredPDF(nObs, ȳ, σ, μPri, σPri)
+	cdf := NormMuPredCDF(nObs, ȳ, σ, μPri, σPri)
+	qtl := NormMuPredQtl(nObs, ȳ, σ, μPri, σPri)
+	if !check(pdf(μPost), 1/(sd*math.Sqrt(2*math.Pi))) || !check(cdf(μPost), 0.5) || !check(qtl(0.5), μPost) {
+		t.Error()
+		fmt.Println(pdf(μPost), cdf(μPost), qtl(0.5), μPost, sd)
+	}
+	for _, x := range []float64{4, 9, 13} {
+		if !check(cdf(qtl(cdf(x))), cdf(x)) {
+			t.Error()
+			fmt.Println(x, cdf(x), qtl(cdf(x)))
+		}
+	}
+	// wider than the posterior of μ, and never narrower than σ alone
+	if !(sd > σPost && sd > σ) {
+		t.Error()
+		fmt.Println(sd, σPost, σ)
+	}
+
+	width := func(nObs int, σPri float64) float64 {
+		lo, hi := CrI(α, NormMuPredQtl(nObs, ȳ, σ, μPri, σPri))
+		return hi - lo
+	}
+	// predictive interval widens with a vaguer prior ...
+	prev := 0.0
+	for _, s := range []float64{0.1, 0.5, 1, 3, 10, 100} {
+		w := width(nObs, s)
+		if !(w > prev) {
+			t.Error()
+			fmt.Println("σPri", s, w, prev)
+		}
+		prev = w
+	}
+	// ... and shrinks as the sample grows, towards the width of Normal(·, σ)
+	prev = math.Inf(1)
+	for _, n := range []int{0, 1, 2, 5, 20, 100, 10000} {
+		w := width(n, σPri)
+		if !(w < prev) {
+			t.Error()
+			fmt.Println("nObs", n, w, prev)
+		}
+		prev = w
+	}
+	if !check(prev, 2*1.959963985*σ) {
+		t.Error()
+		fmt.Println(prev)
+	}
+
+	// single observation
+	single := NormMuSinglePredPDF(ȳ, σ, μPri, σPri)
+	one := NormMuPredPDF(1, ȳ, σ, μPri, σPri)
+	for _, x := range []float64{5, 9.5, 12} {
+		if single(x) != one(x) {
+			t.Error()
+			fmt.Println(x, single(x), one(x))
+		}
+	}
+
+	if !panics(func() { NormMuPredPDF(nObs, ȳ, 0, μPri, σPri) }) {
+		t.Error()
+		fmt.Println("no panic for σ = 0")
+	}
+}
+
+func TestNormMuUpdate(t *testing.T) {
+	fmt.Println("Testing NormMuUpdate")
+	obs := []float64{9.8, 10.4, 11.1, 9.2, 10.9, 10.0, 8.7, 10.6}
+	σ := 1.5
+
+	// one at a time, in two batches, and all at once
+	μ1, σ1 := 8.0, 3.0
+	for _, y := range obs {
+		μ1, σ1 = NormMuUpdate(μ1, σ1, σ, []float64{y})
+	}
+	μ2, σ2 := NormMuUpdate(8, 3, σ, obs[:3])
+	μ2, σ2 = NormMuUpdate(μ2, σ2, σ, obs[3:])
+	μ3, σ3 := NormMuUpdate(8, 3, σ, obs)
+	if !check(μ1, μ3) || !check(σ1, σ3) || !check(μ2, μ3) || !check(σ2, σ3) {
+		t.Error()
+		fmt.Println(μ1, σ1, μ2, σ2, μ3, σ3)
+	}
+	// the batch update is NormMuPostMean and NormMuPostStd of the sample mean
+	ȳ := 0.0
+	for _, y := range obs {
+		ȳ += y / float64(len(obs))
+	}
+	if !check(μ3, NormMuPostMean(len(obs), ȳ, σ, 8, 3)) || !check(σ3, NormMuPostStd(len(obs), σ, 8, 3)) {
+		t.Error()
+		fmt.Println(μ3, σ3)
+	}
+
+	// no new observations: the prior unchanged
+	for _, empty := range [][]float64{nil, {}} {
+		if μ, s := NormMuUpdate(8, 3, σ, empty); μ != 8 || s != 3 {
+			t.Error()
+			fmt.Println(μ, s)
+		}
+	}
+
+	if !panics(func() { NormMuUpdate(8, 0, σ, obs) }) {
+		t.Error()
+		fmt.Println("no panic for σPri = 0")
+	}
+}
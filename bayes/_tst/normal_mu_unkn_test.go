@@ -0,0 +1,36 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+// expected values: ȳ ± qt(0.975, nObs-1) * σPost, computed in R
+func TestNormMuCrIUnkn(t *testing.T) {
+	fmt.Println("Testing NormMuCrIFPriUnkn #1")
+	lo, hi := NormMuCrIFPriUnkn(10, 5, 2, 0.05)
+	if !check(lo, 3.569294) || !check(hi, 6.430706) {
+		t.Error()
+		fmt.Println(lo, hi)
+	}
+
+	fmt.Println("Testing NormMuCrINPriUnkn #1")
+	lo, hi = NormMuCrINPriUnkn(25, 10, 5, 0, 100, 0.05)
+	if !check(lo, 7.935204) || !check(hi, 12.062796) {
+		t.Error()
+		fmt.Println(lo, hi)
+	}
+
+	// a vague prior gives nearly the flat-prior interval
+	fmt.Println("Testing NormMuCrINPriUnkn #2")
+	lo1, hi1 := NormMuCrINPriUnkn(10, 5, 2, 0, 1e6, 0.05)
+	lo2, hi2 := NormMuCrIFPriUnkn(10, 5, 2, 0.05)
+	if !check(lo1, lo2) || !check(hi1, hi2) {
+		t.Error()
+		fmt.Println(lo1, hi1, lo2, hi2)
+	}
+
+	if panicMsg(func() { NormMuCrIFPriUnkn(1, 5, 2, 0.05) }) == "" {
+		t.Error("nObs < 2 accepted")
+	}
+}
@@ -0,0 +1,52 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNormalPrior(t *testing.T) {
+	fmt.Println("Testing NormalPrior")
+	nObs, ȳ, σ := 25, 4.2, 1.5
+	μPri, σPri := 3.0, 2.0
+	post := NormalPrior{μPri, σPri}.Update(nObs, ȳ, σ)
+	if post.Mean() != NormMuPostMean(nObs, ȳ, σ, μPri, σPri) || post.Std() != NormMuPostStd(nObs, σ, μPri, σPri) {
+		fmt.Println("failed: moments ", post)
+		t.Error()
+	}
+	lo, hi := post.CrI(0.05)
+	lo0, hi0 := NormMuCrINPriKnown(nObs, ȳ, σ, μPri, σPri, 0.05)
+	if !check(lo, lo0) || !check(hi, hi0) {
+		fmt.Println("failed: CrI ", lo, hi, lo0, hi0)
+		t.Error()
+	}
+	if !check(post.Qtl()(0.9), NormMuQtlNPri(nObs, ȳ, σ, μPri, σPri, 0.9)) {
+		fmt.Println("failed: Qtl ", post.Qtl()(0.9))
+		t.Error()
+	}
+
+	// sequential updates equal one update with all the data: 10 obs with mean 4, then 15 with mean 4 + 1/3
+	seq := NormalPrior{μPri, σPri}.Update(10, 4, σ).Update(15, 4+1./3, σ)
+	if !check(seq.Mean(), post.Mean()) || !check(seq.Std(), post.Std()) {
+		fmt.Println("failed: sequential update ", seq, post)
+		t.Error()
+	}
+
+	d := post.Diff(NormalPrior{1, 0.5})
+	if !check(d.Mean(), post.Mu-1) || !check(d.Std(), math.Sqrt(post.Sigma*post.Sigma+0.25)) {
+		fmt.Println("failed: Diff ", d)
+		t.Error()
+	}
+	pdf := NormalMuDiffPDFNPriKn(nObs, 10, ȳ, 2, σ, 1, μPri, σPri, 0, 3)
+	d = post.Diff(NormalPrior{0, 3}.Update(10, 2, 1))
+	if pdf(2.1) != d.PDF()(2.1) {
+		fmt.Println("failed: NormalMuDiffPDFNPriKn ", pdf(2.1), d.PDF()(2.1))
+		t.Error()
+	}
+	if !panics(func() { NormalPrior{0, 0}.Update(5, 1, 1) }) || !panics(func() { NormalPrior{0, 1}.Update(-1, 1, 1) }) ||
+		!panics(func() { NormalPrior{0, 1}.Update(5, 1, 0) }) {
+		fmt.Println("failed: bad arguments accepted")
+		t.Error()
+	}
+}
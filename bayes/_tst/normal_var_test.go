@@ -0,0 +1,103 @@
+package bayes
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Frequentist interval for σ², numerically equal under Jeffreys' prior:
+// n = 20, s² = 0.0153; χ²(0.975, 19) = 32.8523, χ²(0.025, 19) = 8.90655
+func TestNormSigmaSqCrIJPri(t *testing.T) {
+	fmt.Println("Testing NormSigmaSqCrIJPri")
+	lo, hi := NormSigmaSqCrIJPri(20, 0.0153, 0.05)
+	if !check(lo, 19*0.0153/32.8523) || !check(hi, 19*0.0153/8.90655) {
+		fmt.Println("failed: ", lo, hi)
+		t.Error()
+	}
+	if !(lo < 0.0153 && 0.0153 < hi) {
+		fmt.Println("failed: s² outside the interval ", lo, hi)
+		t.Error()
+	}
+}
+
+// Chi-squared pivot: under InvGamma(α, β) prior, 2βPost/σ² ~ χ²(2αPost).
+// α = 3, β = 2, n = 10, s² = 1.5: αPost = 3 + 9/2, βPost = 2 + 9·1.5/2 = 8.75; χ²(0.975, 15) = 27.48839, χ²(0.025, 15) = 6.262138.
+func TestNormSigmaSqIGPri(t *testing.T) {
+	fmt.Println("Testing NormSigmaSqCrIIGPri")
+	// Jeffreys' prior gives the interval of NormSigmaSqCrIJPri
+	lo, hi := NormSigmaSqCrIIGPri(20, 0.0153, 0, 0, 0.05)
+	lo1, hi1 := NormSigmaSqCrIJPri(20, 0.0153, 0.05)
+	if !check(lo, lo1) || !check(hi, hi1) {
+		fmt.Println("failed: Jeffreys' prior ", lo, hi, lo1, hi1)
+		t.Error()
+	}
+	lo, hi = NormSigmaSqCrIIGPri(10, 1.5, 3, 2, 0.05)
+	if !check(lo, 17.5/27.48839) || !check(hi, 17.5/6.262138) {
+		fmt.Println("failed: InvGamma prior ", lo, hi)
+		t.Error()
+	}
+
+	// the CDF inverts the quantile function, also for large samples
+	for _, n := range []int{10, 1000} {
+		cdf := NormSigmaSqCDFIGPri(n, 1.5, 3, 2)
+		qtl := NormSigmaSqQtlIGPri(n, 1.5, 3, 2)
+		for _, p := range []float64{0.05, 0.5, 0.9} {
+			if !check(cdf(qtl(p)), p) {
+				fmt.Println("failed: CDF(Qtl(p)) ", n, p, cdf(qtl(p)))
+				t.Error()
+			}
+		}
+	}
+	pdf, pdf0 := NormSigmaSqPDFJPri(20, 0.0153), NormSigmaSqPDFIGPri(20, 0.0153, 0, 0)
+	if pdf(0.02) != pdf0(0.02) || !(pdf(0.02) > 0) {
+		fmt.Println("failed: NormSigmaSqPDFJPri ", pdf(0.02), pdf0(0.02))
+		t.Error()
+	}
+	if !panics(func() { NormSigmaSqPDFIGPri(10, -1, 3, 2) }) || !panics(func() { NormSigmaSqCDFIGPri(1, 1, 3, 2) }) ||
+		!panics(func() { NormSigmaSqQtlIGPri(10, 1, -3, 2) }) {
+		fmt.Println("failed: bad arguments accepted")
+		t.Error()
+	}
+}
+
+func TestNormVarPost(t *testing.T) {
+	fmt.Println("Testing NormVarPost functions")
+	// n = 6, ȳ = 5, Σ(y-ȳ)² = 10, s² = 2
+	y := []float64{3, 4, 5, 5, 6, 7}
+	df, scale := NormVarPostParams(y, 4, 1.5)
+	if !check(df, 9) || !check(scale, (4*1.5+10)/9.) {
+		fmt.Println("failed: NormVarPostParams ", df, scale)
+		t.Error()
+	}
+
+	// the mean of the posterior PDF, by the midpoint rule, is scale·df/(df-2)
+	pdf := NormVarPostPDF(df, scale)
+	const h = 1e-3
+	m := 0.0
+	for x := h / 2; x < 400; x += h {
+		m += x * pdf(x) * h
+	}
+	if !check(NormVarPostMean(df, scale), scale*df/(df-2)) || !check(m, scale*df/(df-2)) {
+		fmt.Println("failed: NormVarPostMean ", NormVarPostMean(df, scale), m, scale*df/(df-2))
+		t.Error()
+	}
+	cdf, qtl := NormVarPostCDF(df, scale), NormVarPostQtl(df, scale)
+	if !check(cdf(qtl(0.8)), 0.8) {
+		fmt.Println("failed: CDF(Qtl(0.8)) ", cdf(qtl(0.8)))
+		t.Error()
+	}
+
+	// Jeffreys' prior: the interval of NormSigmaSqCrIJPri
+	df, scale = NormVarPostParams(y, 0, 0)
+	lo, hi := NormVarPostCrI(df, scale, 0.05)
+	lo1, hi1 := NormSigmaSqCrIJPri(len(y), 2, 0.05)
+	if !check(lo, lo1) || !check(hi, hi1) {
+		fmt.Println("failed: NormVarPostCrI ", lo, hi, lo1, hi1)
+		t.Error()
+	}
+	if !panics(func() { NormVarPostParams(y[:1], 4, 1.5) }) || !panics(func() { NormVarPostParams([]float64{1, 1}, 0, 0) }) ||
+		!panics(func() { NormVarPostParams(y, -1, 1.5) }) || !panics(func() { NormVarPostPDF(0, 1) }) {
+		fmt.Println("failed: bad arguments accepted")
+		t.Error()
+	}
+}
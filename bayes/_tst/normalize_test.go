@@ -0,0 +1,83 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNormalizeLogWeights(t *testing.T) {
+	fmt.Println("Testing NormalizeLogWeights")
+	logw := []float64{-1000, -1002, -998}
+
+	// the naive path underflows to 0/0
+	naive := 0.0
+	for _, l := range logw {
+		naive += math.Exp(l)
+	}
+	if naive != 0 {
+		t.Error("naive sum did not underflow")
+	}
+
+	w := NormalizeLogWeights(logw)
+	// exp(0), exp(-2), exp(2) normalized
+	s := 1 + math.Exp(-2) + math.Exp(2)
+	want := []float64{1 / s, math.Exp(-2) / s, math.Exp(2) / s}
+	for i := range w {
+		if !check(w[i], want[i]) {
+			t.Error()
+			fmt.Println(i, w[i], want[i])
+		}
+	}
+
+	w = NormalizeLogWeights([]float64{math.Inf(-1), 0, 0})
+	if w[0] != 0 || !check(w[1], 0.5) {
+		t.Error("-Inf weight")
+	}
+	if panicMsg(func() { NormalizeLogWeights([]float64{math.Inf(-1)}) }) == "" {
+		t.Error("all -Inf accepted")
+	}
+
+	fmt.Println("Testing NormMuPMFDPri with a large sample")
+	// the likelihood of μ = 0 underflows in the naive product, but the posterior is fine
+	post := NormMuPMFDPri(10000, 1.1, 1, []float64{0, 1, 1.2}, []float64{1. / 3, 1. / 3, 1. / 3})
+	if math.IsNaN(post[1]) || !check(post[1]+post[2], 1) || post[0] != 0 {
+		t.Error()
+		fmt.Println(post)
+	}
+
+	fmt.Println("Testing PropDisc at p = 0")
+	post = PropDisc([]float64{0, 0.5}, []float64{0.5, 0.5}, 0, 3)
+	// likelihoods 1 and 1/8
+	if !check(post[0], 8.0/9) {
+		t.Error()
+		fmt.Println(post)
+	}
+}
+
+func TestNormMuPMFDPriSingle(t *testing.T) {
+	fmt.Println("Testing NormMuPMFDPri against NormMuSinglePMFDPri")
+	// one observation is a sample of size 1: the posteriors must agree exactly
+	μ := []float64{-2, -1.5, -0.25, 0, 0.3, 1, 2.75, 4}
+	μPri := []float64{0.05, 0.1, 0.2, 0.15, 0.15, 0.2, 0.1, 0.05}
+	for _, y := range []float64{-3.3, -1, 0, 0.17, 1.9, 6} {
+		for _, σ := range []float64{0.1, 0.7, 1, 2.5, 40} {
+			single := NormMuSinglePMFDPri(y, σ, μ, μPri)
+			sample := NormMuPMFDPri(1, y, σ, μ, μPri)
+			for i := range μ {
+				if single[i] != sample[i] {
+					t.Error()
+					fmt.Println(y, σ, μ[i], single[i], sample[i])
+				}
+			}
+		}
+	}
+	// no observations leave the prior
+	post := NormMuPMFDPri(0, 1.5, 1, μ, μPri)
+	for i := range μ {
+		if !check(post[i], μPri[i]) {
+			t.Error()
+			fmt.Println(μ[i], post[i], μPri[i])
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package bayes
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"testing"
+)
+
+// Round trip: the central interval of the elicited prior reproduces [lo, hi].
+func TestNormalPriorFromInterval(t *testing.T) {
+	fmt.Println("test for NormalPriorFromInterval")
+	lo := []float64{10, -3, 0.5}
+	hi := []float64{20, 7, 0.6}
+	conf := []float64{0.95, 0.5, 0.99}
+	for i := range lo {
+		μ, σ := NormalPriorFromInterval(lo[i], hi[i], conf[i])
+		x := dst.NormalQtlFor(μ, σ, (1-conf[i])/2)
+		y := dst.NormalQtlFor(μ, σ, (1+conf[i])/2)
+		if abs(x-lo[i]) > 1e-6 || abs(y-hi[i]) > 1e-6 {
+			t.Error()
+			fmt.Println(lo[i], hi[i], x, y)
+		}
+	}
+}
+
+// Symmetric inputs give the prior mean at the midpoint.
+func TestNormalPriorFromIntervalMidpoint(t *testing.T) {
+	fmt.Println("test for NormalPriorFromInterval midpoint")
+	μ, σ := NormalPriorFromInterval(10, 20, 0.95)
+	if !check(μ, 15) {
+		t.Error()
+		fmt.Println(μ, 15)
+	}
+	// 1.959964 is the 0.975 quantile of the standard Normal
+	if !check(σ, 5/1.959964) {
+		t.Error()
+		fmt.Println(σ, 5/1.959964)
+	}
+}
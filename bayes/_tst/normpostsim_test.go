@@ -4,6 +4,8 @@ package bayes
 
 import (
 	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
 	"testing"
 )
 
@@ -60,3 +62,44 @@ func TestNormPostNoPriorNext(t *testing.T) {
 		t.Error()
 	}
 }
+
+func TestNormJointPostSample(t *testing.T) {
+	fmt.Println("Testing NormJointPostSample()")
+	// n = 20, ȳ = 10, s² = 4; prior μ0 = 8, κ0 = 5, ν0 = 3, σ02 = 3
+	// κn = 25, μn = 9.6, νn = 23, νnσn² = 9 + 76 + 16 = 101
+	m := 40000
+	mu, s2 := NormJointPostSample(20, 10, 4, 8, 5, 3, 3, m)
+	σ2n := 101. / 23
+	if abs(mean(mu)-9.6) > 0.02 || abs(mean(s2)/(101./21)-1) > 0.02 {
+		fmt.Println("means failed: ", mean(mu), mean(s2))
+		t.Error()
+	}
+
+	// the marginal of μ is t(νn) with location μn and scale σn/√κn
+	half := StudentsTQtlFor(23, 0.975) * math.Sqrt(σ2n/25)
+	lo, hi := NormVarPostCrI(23, σ2n, 0.05)
+	inMu, inS2 := 0, 0
+	for i := range mu {
+		if abs(mu[i]-9.6) <= half {
+			inMu++
+		}
+		if s2[i] >= lo && s2[i] <= hi {
+			inS2++
+		}
+	}
+	if abs(float64(inMu)/float64(m)-0.95) > 0.01 || abs(float64(inS2)/float64(m)-0.95) > 0.01 {
+		fmt.Println("coverage failed: ", float64(inMu)/float64(m), float64(inS2)/float64(m))
+		t.Error()
+	}
+
+	// noninformative limit: σ² ~ (n-1)s²/χ²(n-1), mean (n-1)s²/(n-3)
+	_, s2 = NormJointPostSample(20, 10, 4, 0, 0, -1, 0, m)
+	if abs(mean(s2)/(76./17)-1) > 0.02 {
+		fmt.Println("noninformative failed: ", mean(s2))
+		t.Error()
+	}
+	if !panics(func() { NormJointPostSample(20, 10, 4, 8, -5, 3, 3, m) }) || !panics(func() { NormJointPostSample(20, 10, 4, 8, 5, 3, 3, 0) }) {
+		fmt.Println("bad arguments accepted")
+		t.Error()
+	}
+}
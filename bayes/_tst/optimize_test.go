@@ -0,0 +1,92 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestOptimize2D(t *testing.T) {
+	fmt.Println("Testing Optimize2D")
+	// concave quadratic with its maximum 3 at (1, -2)
+	f := func(a, b float64) float64 {
+		da, db := a-1, b+2
+		return 3 - da*da - 2*db*db + 0.5*da*db
+	}
+	for _, s := range [][2]float64{{0, 0}, {1, -2}, {-50, 30}, {1e3, 1e-3}, {0.9, -2.2}} {
+		a, b, v := Optimize2D(f, s[0], s[1])
+		if math.Abs(a-1) > 1e-6 || math.Abs(b+2) > 1e-6 || math.Abs(v-3) > 1e-10 {
+			t.Error()
+			fmt.Println(s, a, b, v)
+		}
+	}
+
+	// Rosenbrock's banana valley, turned upside down: maximum 0 at (1, 1)
+	rb := func(a, b float64) float64 {
+		return -(1-a)*(1-a) - 100*(b-a*a)*(b-a*a)
+	}
+	for _, s := range [][2]float64{{-1.2, 1}, {2, 2}, {0, 0}} {
+		a, b, v := Optimize2D(rb, s[0], s[1])
+		if math.Abs(a-1) > 1e-4 || math.Abs(b-1) > 1e-4 || v < -1e-8 {
+			t.Error()
+			fmt.Println(s, a, b, v)
+		}
+	}
+
+	// NaN outside the domain a > 0 keeps the search inside it
+	a, b, _ := Optimize2D(func(a, b float64) float64 {
+		if a <= 0 {
+			return math.NaN()
+		}
+		return math.Log(a) - a - b*b
+	}, 5, 1)
+	if math.Abs(a-1) > 1e-6 || math.Abs(b) > 1e-6 {
+		t.Error()
+		fmt.Println(a, b)
+	}
+
+	if !panics(func() { Optimize2D(f, math.NaN(), 0) }) {
+		t.Error()
+		fmt.Println("no panic for NaN starting point")
+	}
+}
+
+func TestPoissonGammaEB(t *testing.T) {
+	fmt.Println("Testing PoissonGammaEB")
+	y := []float64{0, 1, 15, 2, 30, 0, 3, 8, 22, 1}
+	e := []float64{1.2, 0.8, 2.0, 1.1, 2.5, 1.6, 0.7, 1.3, 2.2, 0.9}
+	r, v, ll := PoissonGammaEB(y, e)
+
+	// the log marginal likelihood, sum of negative binomial log probabilities
+	logLik := func(r, v float64) float64 {
+		s := 0.0
+		for i := range y {
+			lg1, _ := math.Lgamma(r + y[i])
+			lg2, _ := math.Lgamma(r)
+			lg3, _ := math.Lgamma(y[i] + 1)
+			s += lg1 - lg2 - lg3 + r*math.Log(v/(v+e[i])) + y[i]*math.Log(e[i]/(v+e[i]))
+		}
+		return s
+	}
+	// logG leaves out log(yi!) - yi*log(ei), which does not depend on r and v
+	c := 0.0
+	for i := range y {
+		lg, _ := math.Lgamma(y[i] + 1)
+		c += lg - y[i]*math.Log(e[i])
+	}
+	if math.Abs(logLik(r, v)+c-ll) > 1e-8 {
+		t.Error()
+		fmt.Println(r, v, logLik(r, v)+c, ll)
+	}
+	// no neighbouring point does better
+	for _, d := range [][2]float64{{1.01, 1}, {0.99, 1}, {1, 1.01}, {1, 0.99}, {1.01, 1.01}, {0.99, 0.99}} {
+		if logLik(r*d[0], v*d[1]) > logLik(r, v) {
+			t.Error()
+			fmt.Println(r, v, d)
+		}
+	}
+	if !panics(func() { PoissonGammaEB([]float64{0, 0}, []float64{1, 1}) }) {
+		t.Error()
+		fmt.Println("no panic without events")
+	}
+}
@@ -0,0 +1,134 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+	"testing"
+)
+
+func TestPoissonForecast(t *testing.T) {
+	fmt.Println("Testing PoissonForecast")
+	// 52 incidents in 13 weeks, weak gamma prior
+	sumK, n := int64(52), int64(13)
+	r, v := 1.0, 0.1
+	last := int64(-1)
+	for _, h := range []int64{1, 2, 4, 8, 26} {
+		expected, lo, hi := PoissonForecast(sumK, n, r, v, h, 0.05)
+		if !check(expected, float64(h)*(r+float64(sumK))/(v+float64(n))) {
+			fmt.Println("failed: expected ", h, expected)
+			t.Error()
+		}
+		if float64(lo) > expected || float64(hi) < expected {
+			fmt.Println("failed: interval does not contain the expected value ", h, expected, lo, hi)
+			t.Error()
+		}
+		if hi-lo <= last {
+			fmt.Println("failed: interval does not widen with horizon ", h, lo, hi)
+			t.Error()
+		}
+		last = hi - lo
+	}
+
+	// interval coverage: the tails beyond the interval hold at most α/2 each
+	r1, v1 := r+float64(sumK), v+float64(n)
+	_, lo, hi := PoissonForecast(sumK, n, r, v, 4, 0.1)
+	ρ := v1 / (v1 + 4)
+	below := BetaCDFAt(r1, float64(lo), ρ) // P(K <= lo-1)
+	above := 1 - BetaCDFAt(r1, float64(hi)+1, ρ)
+	if below > 0.05 || above > 0.05 || BetaCDFAt(r1, float64(lo)+1, ρ) < 0.05 {
+		fmt.Println("failed: tails ", lo, hi, below, above)
+		t.Error()
+	}
+}
+
+func TestPoissonLambdaPred(t *testing.T) {
+	fmt.Println("Testing PoissonPredPMFGPri, PoissonPredCDFGPri")
+	tests := []struct {
+		name   string
+		pmf    func(k int64) float64
+		cdf    func(k int64) float64
+		r1, v1 float64
+	}{
+		{"gamma prior", PoissonPredPMFGPri(52, 13, 2, 0.5), PoissonPredCDFGPri(52, 13, 2, 0.5), 54, 13.5},
+		{"flat prior", PoissonLambdaPredPMFFPri(3, 4), PoissonLambdaPredCDFFPri(3, 4), 4, 4},
+		{"Jeffreys prior", PoissonLambdaPredPMFJPri(0, 2), PoissonLambdaPredCDFJPri(0, 2), 0.5, 2},
+	}
+	for _, tt := range tests {
+		sum, mean, cum := 0.0, 0.0, 0.0
+		for k := int64(0); k < 1000; k++ {
+			p := tt.pmf(k)
+			sum += p
+			mean += float64(k) * p
+			if k < 30 {
+				cum += p
+				if !check(tt.cdf(k), cum) {
+					t.Error()
+					fmt.Println(tt.name, k, tt.cdf(k), cum)
+				}
+			}
+		}
+		if !check(sum, 1) || !check(mean, tt.r1/tt.v1) {
+			t.Error()
+			fmt.Println(tt.name, sum, mean, tt.r1/tt.v1)
+		}
+	}
+
+	// integer size: the Negative binomial of dst, whose ρ is the probability of the counted outcome
+	pmf := PoissonPredPMFGPri(7, 3, 1, 1)
+	for k := int64(0); k < 20; k++ {
+		if x, y := pmf(k), NegBinomialPMFAt(1.0/5, 8, k); !check(x, y) {
+			t.Error()
+			fmt.Println(k, x, y)
+		}
+	}
+
+	if !panics(func() { PoissonPredPMFGPri(0, 5, 0, 1) }) {
+		t.Error()
+		fmt.Println("no panic for improper posterior")
+	}
+}
+
+func TestPoissonPredGPri(t *testing.T) {
+	fmt.Println("Testing PoissonPredPMFGPri, PoissonPredCDFGPri, PoissonPredSampleGPri")
+	// the predictive mean equals the posterior mean of λ, also for counts where Γ(k) overflows
+	for _, d := range []struct {
+		sumK, n int64
+		r, v    float64
+	}{{52, 13, 2, 0.5}, {3, 4, 1, 0}, {90000, 300, 0.5, 0}} {
+		pmf := PoissonPredPMFGPri(d.sumK, d.n, d.r, d.v)
+		cdf := PoissonPredCDFGPri(d.sumK, d.n, d.r, d.v)
+		post := PoissonLambdaPostMean(d.sumK, d.n, d.r, d.v)
+		sum, mean := 0.0, 0.0
+		for k := int64(0); k < int64(10*post)+100; k++ {
+			p := pmf(k)
+			sum += p
+			mean += float64(k) * p
+		}
+		if !check(sum, 1) || !check(mean, post) || !check(cdf(int64(post)), PoissonPredCDFGPri(d.sumK, d.n, d.r, d.v)(int64(post))) {
+			t.Error()
+			fmt.Println(d, sum, mean, post)
+		}
+	}
+
+	k := PoissonPredSampleGPri(52, 13, 2, 0.5, 100000, rand.New(rand.NewSource(3)))
+	m, sd := 0.0, 0.0
+	for _, ki := range k {
+		m += float64(ki)
+	}
+	m /= float64(len(k))
+	for _, ki := range k {
+		sd += (float64(ki) - m) * (float64(ki) - m)
+	}
+	sd = sqrt(sd / float64(len(k)))
+	// variance of the predictive: posterior mean plus posterior variance of λ
+	r1, v1 := 54.0, 13.5
+	if abs(m-r1/v1) > 0.02 || abs(sd-sqrt(r1/v1+r1/(v1*v1))) > 0.02 {
+		t.Error()
+		fmt.Println(m, r1/v1, sd, sqrt(r1/v1+r1/(v1*v1)))
+	}
+	if !panics(func() { PoissonPredSampleGPri(0, 5, 0, 1, 10, nil) }) {
+		t.Error()
+		fmt.Println("no panic for improper posterior")
+	}
+}
@@ -0,0 +1,55 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// skewness returns the sample skewness of x.
+func skewness(x []float64) float64 {
+	m := 0.0
+	for _, v := range x {
+		m += v
+	}
+	m /= float64(len(x))
+	m2, m3 := 0.0, 0.0
+	for _, v := range x {
+		d := v - m
+		m2 += d * d
+		m3 += d * d * d
+	}
+	m2 /= float64(len(x))
+	m3 /= float64(len(x))
+	return m3 / math.Pow(m2, 1.5)
+}
+
+func TestPoissonLogRatioPost(t *testing.T) {
+	fmt.Println("Testing PoissonLogRatioPost: log ratio more symmetric than ratio")
+	const draws = 100000
+	lr := PoissonLogRatioPostSample(5, 4, 3, 4, 1, 0, 1, 0, draws, rand.New(rand.NewSource(1)))
+	ratio := make([]float64, draws)
+	for i, v := range lr {
+		ratio[i] = math.Exp(v)
+	}
+	if math.Abs(skewness(lr)) >= math.Abs(skewness(ratio)) {
+		t.Error()
+		fmt.Println(skewness(lr), skewness(ratio))
+	}
+
+	fmt.Println("Testing PoissonLogRatioCrI: back-transforms to the ratio interval")
+	lo, hi := PoissonLogRatioCrI(300, 10, 200, 10, 1, 0, 1, 0, 0.05)
+	ratio = PoissonRatioPostSample(300, 10, 200, 10, 1, 0, draws, rand.New(rand.NewSource(2)))
+	sort.Float64s(ratio)
+	qLo, qHi := ratio[int(0.025*draws)], ratio[int(0.975*draws)]
+	if !check(math.Exp(lo), qLo) || !check(math.Exp(hi), qHi) {
+		t.Error()
+		fmt.Println(math.Exp(lo), math.Exp(hi), qLo, qHi)
+	}
+
+	if panicMsg(func() { PoissonLogRatioPost(0, 4, 3, 4, 0, 0, 1, 0) }) == "" {
+		t.Error("improper posterior accepted")
+	}
+}
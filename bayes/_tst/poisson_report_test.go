@@ -0,0 +1,80 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPoissonReport(t *testing.T) {
+	fmt.Println("Testing PoissonReport")
+	const α = 0.1
+	for _, c := range []struct {
+		sumK, n int64
+		prior   GammaPrior
+		λ0      float64
+		horizon int64
+	}{
+		{12, 4, GammaPrior{6, 2}, 2.5, 3},
+		{12, 4, GammaPrior{6, 2}, 1.5, 1},  // λ0 far below the posterior: both tests reject
+		{3, 2, GammaPrior{1, 0}, 4, 10},    // flat prior
+		{0, 5, GammaPrior{0.5, 0}, 0.2, 2}, // Jeffreys prior, no events: posterior shape 0.5 has no mode
+	} {
+		r, v := c.prior.R, c.prior.V
+		rep := PoissonReport(c.sumK, c.n, c.prior, α, c.λ0, c.horizon)
+
+		// each field is what the dedicated function returns
+		lo, hi := PoissonLambdaCrIGPri(c.sumK, c.n, r, v, α)
+		hlo, hhi := PoissonLambdaHPDGPri(c.sumK, c.n, r, v, α)
+		e, plo, phi := PoissonForecast(c.sumK, c.n, r, v, c.horizon, α)
+		if rep.Post != c.prior.Update(c.sumK, c.n) ||
+			rep.Mean != PoissonLambdaPostMean(c.sumK, c.n, r, v) ||
+			rep.Median != PoissonLambdaQtlGPri(c.sumK, c.n, r, v)(0.5) ||
+			rep.CrILo != lo || rep.CrIHi != hi || rep.HPDLo != hlo || rep.HPDHi != hhi ||
+			rep.Lambda0 != c.λ0 ||
+			rep.OneSidedReject != PoissonLambdaOneSidedTst(c.sumK, c.n, r, v, α, c.λ0) ||
+			rep.OneSidedOdds != PoissonLambdaOneSidedOdds(c.sumK, c.n, r, v, c.λ0) ||
+			rep.TwoSidedReject != PoissonLambdaTwoSidedTst(c.sumK, c.n, r, v, α, c.λ0) ||
+			rep.Horizon != c.horizon || rep.Expected != e || rep.PredLo != plo || rep.PredHi != phi {
+			t.Error()
+			fmt.Println(c, rep)
+		}
+
+		// mode and standard deviation of the gamma posterior, (R-1)/V and sqrt(R)/V
+		R, V := c.prior.R+float64(c.sumK), c.prior.V+float64(c.n)
+		if !check(rep.SD, math.Sqrt(R)/V) || (R > 1 && !check(rep.Mode, (R-1)/V)) || (R <= 1 && !math.IsNaN(rep.Mode)) {
+			t.Error()
+			fmt.Println(c, rep.Mode, rep.SD)
+		}
+		if !(rep.HPDLo < rep.Median && rep.Median < rep.HPDHi && rep.CrILo < rep.Mean && rep.Mean < rep.CrIHi) {
+			t.Error()
+			fmt.Println(c, rep)
+		}
+	}
+
+	rep := PoissonReport(12, 4, GammaPrior{6, 2}, α, 1.5, 1)
+	if !rep.OneSidedReject || !rep.TwoSidedReject {
+		t.Error()
+		fmt.Println("failed: λ0 = 1.5 not rejected ", rep)
+	}
+	rep = PoissonReport(12, 4, GammaPrior{6, 2}, α, 2.5, 1)
+	if rep.OneSidedReject || rep.TwoSidedReject {
+		t.Error()
+		fmt.Println("failed: λ0 = 2.5 rejected ", rep)
+	}
+
+	for i, f := range []func(){
+		func() { PoissonReport(-1, 4, GammaPrior{6, 2}, α, 2.5, 1) },
+		func() { PoissonReport(12, 0, GammaPrior{6, 2}, α, 2.5, 1) },
+		func() { PoissonReport(12, 4, GammaPrior{-1, 2}, α, 2.5, 1) },
+		func() { PoissonReport(12, 4, GammaPrior{6, 2}, 0, 2.5, 1) },
+		func() { PoissonReport(12, 4, GammaPrior{6, 2}, α, 0, 1) },
+		func() { PoissonReport(12, 4, GammaPrior{6, 2}, α, 2.5, 0) },
+		func() { PoissonReport(0, 4, GammaPrior{0, 0}, α, 2.5, 1) },
+	} {
+		if !panics(f) {
+			t.Error()
+			fmt.Println("failed: no panic for bad arguments ", i)
+		}
+	}
+}
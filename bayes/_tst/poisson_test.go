@@ -0,0 +1,187 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestPoissonLambdaPostMean(t *testing.T) {
+	fmt.Println("Testing PoissonLambdaPostMean")
+	// posterior Gamma(r+sumK, 1/(v+n)) has mean (r+sumK)/(v+n)
+	tests := []struct {
+		sumK, n int64
+		r, v    float64
+		mean    float64
+	}{
+		{12, 4, 1, 0, 13.0 / 4},   // flat prior
+		{12, 4, 0.5, 0, 12.5 / 4}, // Jeffreys prior
+		{12, 4, 6, 2, 18.0 / 6},
+		{0, 10, 2, 0.5, 2 / 10.5},
+		{300, 100, 10, 5, 310.0 / 105},
+	}
+	for _, tt := range tests {
+		x := PoissonLambdaPostMean(tt.sumK, tt.n, tt.r, tt.v)
+		if !check(x, tt.mean) {
+			t.Error()
+			fmt.Println(tt, x)
+		}
+	}
+	// flat prior (r = 1, v = 0) and a proper gamma prior: against the mean of the posterior PDF, by the trapezoid rule
+	for _, c := range [][2]float64{{1, 0}, {6, 2}} {
+		pdf := PoissonLambdaPDFGPri(12, 4, c[0], c[1])
+		const h = 0.001
+		m := 0.0
+		for λ := h; λ < 50; λ += h {
+			m += λ * pdf(λ) * h
+		}
+		if x := PoissonLambdaPostMean(12, 4, c[0], c[1]); !check(x, m) {
+			t.Error()
+			fmt.Println(c, x, m)
+		}
+	}
+	if panicMsg(func() { PoissonLambdaPostMean(1, 0, 1, 1) }) == "" {
+		t.Error("n = 0 accepted")
+	}
+	if panicMsg(func() { PoissonLambdaPostMean(1, 1, -1, 1) }) == "" {
+		t.Error("r < 0 accepted")
+	}
+}
+
+func TestPoissonLambdaLike(t *testing.T) {
+	fmt.Println("Testing PoissonLambdaLike")
+	for _, c := range [][2]int64{{12, 4}, {3, 10}, {250, 20}} {
+		sumK, n := c[0], c[1]
+		// λ^sumK * exp(-n*λ)
+		λ := 1.7
+		if !check(PoissonLambdaLike(sumK, n, λ), math.Pow(λ, float64(sumK))*math.Exp(-float64(n)*λ)) {
+			t.Error()
+			fmt.Println(sumK, n, PoissonLambdaLike(sumK, n, λ))
+		}
+		// maximum at sumK/n
+		best, bestλ := 0.0, 0.0
+		for λ := 0.001; λ < 20; λ += 0.001 {
+			if l := PoissonLambdaLike(sumK, n, λ); l > best {
+				best, bestλ = l, λ
+			}
+		}
+		mle := float64(sumK) / float64(n)
+		if math.Abs(bestλ-mle) > 0.001 {
+			t.Error()
+			fmt.Println(sumK, n, bestλ, mle)
+		}
+	}
+}
+
+func TestPostAsPrior(t *testing.T) {
+	fmt.Println("Testing posteriors fed back as priors")
+	// two batches, one after another, give the posterior of both together
+	g := PoissonLambdaPostGPri(7, 3, 1, 0)
+	g = PoissonLambdaPostGPri(5, 2, g.Shape, g.Rate)
+	if all := PoissonLambdaPostGPri(12, 5, 1, 0); g != all {
+		t.Error("Poisson", g, all)
+	}
+	b := BinomPiPostBPri(3, 10, 1, 1)
+	b = BinomPiPostBPri(4, 5, b.A, b.B)
+	if all := BinomPiPostBPri(7, 15, 1, 1); b != all {
+		t.Error("Binomial", b, all)
+	}
+	if panicMsg(func() { BinomPiPostBPri(-1, 5, 1, 1) }) == "" {
+		t.Error("Binomial: k < 0 accepted")
+	}
+	// Normal, known σ = 2: batch means 1.0 (n = 4) and 2.5 (n = 6)
+	d := NormMuPostNPri(4, 1.0, 2, 0, 10)
+	d = NormMuPostNPri(6, 2.5, 2, d.Mu, d.Sigma)
+	all := NormMuPostNPri(10, 1.9, 2, 0, 10)
+	if !check(d.Mu, all.Mu) || !check(d.Sigma, all.Sigma) {
+		t.Error("Normal", d, all)
+	}
+}
+
+func TestPoissonLambdaUpdate(t *testing.T) {
+	fmt.Println("Testing PoissonLambdaUpdate")
+	counts := []int64{3, 0, 5, 2, 2, 7, 1, 4, 0, 3}
+	r, v := 2.0, 0.5
+	sumK := int64(0)
+	for _, k := range counts {
+		r, v = PoissonLambdaUpdate(r, v, k, 1)
+		sumK += k
+	}
+	rb, vb := PoissonLambdaUpdate(2, 0.5, sumK, int64(len(counts)))
+	if r != rb || v != vb || rb != 2+27 || vb != 10.5 {
+		t.Error()
+		fmt.Println(r, v, rb, vb)
+	}
+	// same posterior as PoissonLambdaPostGPri, and v is a rate: the posterior mean is r/v
+	d := PoissonLambdaPostGPri(sumK, int64(len(counts)), 2, 0.5)
+	if d.Shape != r || d.Rate != v || !check(PoissonLambdaPostMean(sumK, int64(len(counts)), 2, 0.5), r/v) {
+		t.Error()
+		fmt.Println(d, r, v)
+	}
+	// no new data, no change
+	if r1, v1 := PoissonLambdaUpdate(r, v, 0, 0); r1 != r || v1 != v {
+		t.Error()
+		fmt.Println(r1, v1)
+	}
+
+	for _, c := range [][4]float64{{-1, 1, 0, 1}, {1, -1, 0, 1}, {1, 1, -1, 1}, {1, 1, 3, 0}} {
+		if !panics(func() { PoissonLambdaUpdate(c[0], c[1], int64(c[2]), int64(c[3])) }) {
+			t.Error()
+			fmt.Println("no panic for", c)
+		}
+	}
+}
+
+func TestPoissonLambdaBayesFactor(t *testing.T) {
+	fmt.Println("Testing PoissonLambdaBayesFactor")
+	// no data: the posterior is the prior, whatever the prior
+	for _, d := range []struct{ r, v, λ0 float64 }{{2, 1, 3}, {0.5, 0.01, 0.2}, {40, 8, 5}} {
+		if bf := PoissonLambdaBayesFactor(0, 0, d.r, d.v, d.λ0); bf != 1 {
+			t.Error()
+			fmt.Println("failed: no data ", d, bf)
+		}
+	}
+
+	// gamma(2, 1) prior, 10 events in 2 intervals, λ0 = 3: integer shapes, closed form CDFs
+	bf := PoissonLambdaBayesFactor(10, 2, 2, 1, 3)
+	if !check(bf, 0.0610031269061924) {
+		t.Error()
+		fmt.Println(bf)
+	}
+	p0 := 1 - 4*math.Exp(-3)
+	if !check(bf, PoissonLambdaOneSidedOdds(10, 2, 2, 1, 3)/(p0/(1-p0))) {
+		t.Error()
+		fmt.Println("failed: not posterior odds over prior odds ", bf)
+	}
+
+	// few events favour H0: λ <= λ0, many favour H1
+	if PoissonLambdaBayesFactor(2, 4, 2, 1, 3) <= 1 || PoissonLambdaBayesFactor(30, 4, 2, 1, 3) >= 1 {
+		t.Error()
+		fmt.Println("failed: direction ", PoissonLambdaBayesFactor(2, 4, 2, 1, 3), PoissonLambdaBayesFactor(30, 4, 2, 1, 3))
+	}
+
+	if !panics(func() { PoissonLambdaBayesFactor(10, 2, 1, 0, 3) }) || !panics(func() { PoissonLambdaBayesFactor(10, 2, 2, 1, 0) }) {
+		t.Error()
+		fmt.Println("failed: no panic for improper prior or bad λ0")
+	}
+}
+
+func TestPoissonLambdaNextGPriSampler(t *testing.T) {
+	fmt.Println("Testing PoissonLambdaNextGPriSampler")
+	smp1, smp2 := NewSampler(7), NewSampler(7)
+	sum := 0.0
+	const draws = 20000
+	for i := 0; i < draws; i++ {
+		x := PoissonLambdaNextGPriSampler(37, 12, 2, 0.5, smp1)
+		if y := PoissonLambdaNextGPriSampler(37, 12, 2, 0.5, smp2); x != y {
+			t.Error("same seed, different draws", x, y)
+			break
+		}
+		sum += x
+	}
+	// posterior mean (r+sumK)/(v+n) = 39/12.5, posterior sd sqrt(39)/12.5 = 0.5
+	if mean := sum / draws; math.Abs(mean-39/12.5) > 4*0.5/math.Sqrt(draws) {
+		t.Error("mean", mean)
+	}
+}
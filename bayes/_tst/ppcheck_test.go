@@ -0,0 +1,111 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPPCheckBootstrap(t *testing.T) {
+	fmt.Println("Testing PPCheckBootstrap")
+	sd := func(x []float64) float64 {
+		m := 0.0
+		for _, v := range x {
+			m += v
+		}
+		m /= float64(len(x))
+		ss := 0.0
+		for _, v := range x {
+			ss += (v - m) * (v - m)
+		}
+		return math.Sqrt(ss / float64(len(x)-1))
+	}
+	// Normal model with KNOWN σ = 1 and flat prior: μ | data ~ Normal(ȳ, 1/√n)
+	run := func(data []float64) PPCheck {
+		n := len(data)
+		ȳ := 0.0
+		for _, v := range data {
+			ȳ += v
+		}
+		ȳ /= float64(n)
+		replicate := func(rng *rand.Rand) []float64 {
+			μ := ȳ + rng.NormFloat64()/math.Sqrt(float64(n))
+			rep := make([]float64, n)
+			for i := range rep {
+				rep[i] = μ + rng.NormFloat64()
+			}
+			return rep
+		}
+		return PPCheckBootstrap(data, sd, replicate, 2000, 2000, 0.05, rand.New(rand.NewSource(2)))
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	good := make([]float64, 50)
+	bad := make([]float64, 50)
+	for i := range good {
+		good[i] = 5 + rng.NormFloat64()
+		bad[i] = 5 + 3*rng.NormFloat64() // true σ is 3, the model assumes 1
+	}
+
+	r := run(good)
+	med := r.Predictive[len(r.Predictive)/2]
+	if !(r.BootLo < med && med < r.BootHi) || r.Overlap < 0.5 || r.PValue < 0.05 || r.PValue > 0.95 {
+		t.Error("well-specified model")
+		fmt.Println(r.Observed, r.BootLo, r.BootHi, med, r.Overlap, r.PValue)
+	}
+
+	r = run(bad)
+	if r.BootLo < r.Predictive[len(r.Predictive)-1] || r.Overlap != 0 || r.PValue != 0 {
+		t.Error("mis-specified model")
+		fmt.Println(r.Observed, r.BootLo, r.BootHi, r.Overlap, r.PValue)
+	}
+}
+
+func TestPoissonOverdispersionPPP(t *testing.T) {
+	fmt.Println("Testing PoissonOverdispersionPPP")
+	rng := rand.New(rand.NewSource(5))
+	poisson := func(λ float64) int64 {
+		k, p := int64(0), math.Exp(-λ)
+		for u := rng.Float64(); u > p; k++ {
+			u -= p
+			p *= λ / float64(k+1)
+		}
+		return k
+	}
+	// Poisson data: the p-value averages near 0.5
+	sum := 0.0
+	for d := 0; d < 20; d++ {
+		counts := make([]int64, 40)
+		for i := range counts {
+			counts[i] = poisson(4)
+		}
+		sum += PoissonOverdispersionPPP(counts, 1, 0, 500, rng)
+	}
+	if m := sum / 20; m < 0.35 || m > 0.65 {
+		t.Error()
+		fmt.Println("Poisson data", m)
+	}
+	// overdispersed data, a gamma mixture of Poissons: p near 0
+	counts := make([]int64, 40)
+	for i := range counts {
+		counts[i] = poisson(4 * rng.ExpFloat64())
+	}
+	if p := PoissonOverdispersionPPP(counts, 1, 0, 1000, rng); p > 0.01 {
+		t.Error()
+		fmt.Println("overdispersed data", p)
+	}
+	// underdispersed data: p near 1
+	for i := range counts {
+		counts[i] = 4 + int64(i%2)
+	}
+	if p := PoissonOverdispersionPPP(counts, 1, 0, 1000, rng); p < 0.99 {
+		t.Error()
+		fmt.Println("underdispersed data", p)
+	}
+
+	if !panics(func() { PoissonOverdispersionPPP([]int64{3}, 1, 0, 100, rng) }) {
+		t.Error()
+		fmt.Println("no panic for a single count")
+	}
+}
@@ -0,0 +1,50 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"testing"
+)
+
+func TestParsePrior(t *testing.T) {
+	fmt.Println("Testing ParseGammaPrior, ParseBetaPrior")
+	gamma := map[string]GammaPrior{
+		"flat":                       {1, 0},
+		" Jeffreys ":                 {0.5, 0},
+		"jeffreys":                   {0.5, 0},
+		"Gamma(shape=2, rate=1)":     {2, 1},
+		"Gamma(3.5,2.0)":             {3.5, 2},
+		GammaPrior{1.25, 8}.String(): {1.25, 8},
+	}
+	for s, want := range gamma {
+		if g, err := ParseGammaPrior(s); err != nil || g != want {
+			t.Error()
+			fmt.Println(s, g, err)
+		}
+	}
+	for _, s := range []string{"", "uniform", "Beta(2, 5)", "Normal(0, 1)", "Gamma(1, 0)", "Gamma(2)"} {
+		if _, err := ParseGammaPrior(s); err == nil {
+			t.Error()
+			fmt.Println("failed: accepted ", s)
+		}
+	}
+
+	beta := map[string]BetaDist{
+		"Flat":           {A: 1, B: 1},
+		"Jeffreys":       {A: 0.5, B: 0.5},
+		"Beta(α=2, β=5)": {A: 2, B: 5},
+		"Beta(2,5)":      {A: 2, B: 5},
+	}
+	for s, want := range beta {
+		if p, err := ParseBetaPrior(s); err != nil || p != want {
+			t.Error()
+			fmt.Println(s, p, err)
+		}
+	}
+	for _, s := range []string{"", "Gamma(2, 1)", "Beta(0, 1)", "Beta(2, 5"} {
+		if _, err := ParseBetaPrior(s); err == nil {
+			t.Error()
+			fmt.Println("failed: accepted ", s)
+		}
+	}
+}
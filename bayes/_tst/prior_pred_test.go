@@ -0,0 +1,54 @@
+package bayes
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func int64MeanSd(k []int64) (float64, float64) {
+	x := make([]float64, len(k))
+	for i, v := range k {
+		x[i] = float64(v)
+	}
+	return meanSd(x)
+}
+
+// Prior predictive mean of the Poisson model is n*r/v.
+func TestPoissonPriorPredictiveSample(t *testing.T) {
+	fmt.Println("test for PoissonPriorPredictiveSample")
+	rng := rand.New(rand.NewSource(1))
+	r, v := 6.0, 2.0
+	n := int64(4)
+	k := PoissonPriorPredictiveSample(r, v, n, 200000, rng)
+	x, _ := int64MeanSd(k)
+	y := float64(n) * r / v
+	if !check(x, y) {
+		t.Error()
+		fmt.Println(x, y)
+	}
+}
+
+// Tightening the prior (same mean, larger r and v) narrows the predictive spread.
+func TestPoissonPriorPredictiveSpread(t *testing.T) {
+	fmt.Println("test for PoissonPriorPredictiveSample spread")
+	rng := rand.New(rand.NewSource(2))
+	_, wide := int64MeanSd(PoissonPriorPredictiveSample(3, 1, 10, 100000, rng))
+	_, tight := int64MeanSd(PoissonPriorPredictiveSample(300, 100, 10, 100000, rng))
+	if !(tight < wide) {
+		t.Error()
+		fmt.Println(tight, wide)
+	}
+}
+
+// Prior predictive of the sample mean is Normal(μPri, sqrt(σPri^2 + σ^2/n)).
+func TestNormMuPriorPredictiveSample(t *testing.T) {
+	fmt.Println("test for NormMuPriorPredictiveSample")
+	rng := rand.New(rand.NewSource(3))
+	ȳ := NormMuPriorPredictiveSample(10, 2, 4, 4, 200000, rng)
+	m, s := meanSd(ȳ)
+	if !check(m, 10) || !check(s, sqrt(4+4)) {
+		t.Error()
+		fmt.Println(m, s)
+	}
+}
@@ -0,0 +1,59 @@
+package bayes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestInferenceRecord(t *testing.T) {
+	fmt.Println("Testing InferenceRecord")
+	log := []InferenceRecord{
+		Record("PoissonLambdaCrIGPri", 0.05, map[string]float64{"sumK": 37, "n": 12}, map[string]float64{"r": 2, "v": 0.5}),
+		Record("BinomPiCrIBP", 0.1, map[string]float64{"n": 40, "k": 13}, map[string]float64{"α": 1, "β": 1}),
+		Record("NormMuCrINPriKnown", 0.05, map[string]float64{"nObs": 25, "ȳ": 4.2, "σ": 1.5}, map[string]float64{"μPri": 3, "σPri": 2}),
+		Record("NormMuCrIFPriKnown", 0.01, map[string]float64{"nObs": 25, "ȳ": 4.2, "σ": 1.5}, nil),
+		Record("NormSigmaSqCrIJPri", 0.05, map[string]float64{"nObs": 15, "sampleVar": 2.3}, nil),
+		Record("NormalMuDiffCrIJPriUn", 0.05,
+			map[string]float64{"nObs1": 12, "nObs2": 20, "ȳ1": 10.3, "ȳ2": 8.9, "s1": 1.8, "s2": 3.1}, nil),
+	}
+	// the outputs are those of the direct calls
+	lo, hi := PoissonLambdaCrIGPri(37, 12, 2, 0.5, 0.05)
+	if log[0].Output["lo"] != lo || log[0].Output["hi"] != hi {
+		fmt.Println("failed: output ", log[0].Output, lo, hi)
+		t.Error()
+	}
+	lo, hi = NormalMuDiffCrIJPriUn(12, 20, 10.3, 8.9, 1.8, 3.1, 0.05)
+	if log[5].Output["lo"] != lo || log[5].Output["hi"] != hi {
+		fmt.Println("failed: output ", log[5].Output, lo, hi)
+		t.Error()
+	}
+	if !panics(func() { Record("NormMuCrIJPri", 0.05, nil, nil) }) {
+		fmt.Println("failed: no panic for an unknown function")
+		t.Error()
+	}
+
+	for _, rec := range log {
+		b, err := json.Marshal(rec)
+		if err != nil {
+			fmt.Println("failed: marshal ", rec.Function, err)
+			t.Error()
+			continue
+		}
+		var back InferenceRecord
+		if err := json.Unmarshal(b, &back); err != nil || !reflect.DeepEqual(rec, back) {
+			fmt.Println("failed: round trip ", rec.Function, err, string(b))
+			t.Error()
+			continue
+		}
+		if back.Version != Version {
+			fmt.Println("failed: version ", back.Version)
+			t.Error()
+		}
+		if out := Replay(back); !reflect.DeepEqual(out, rec.Output) {
+			fmt.Println("failed: replay ", rec.Function, out, rec.Output)
+			t.Error()
+		}
+	}
+}
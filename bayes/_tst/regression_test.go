@@ -0,0 +1,86 @@
+package bayes
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestRegression(t *testing.T) {
+	fmt.Println("Testing RegSlopePostMean, RegInterceptPostMean, RegSlopeCrI, RegPred")
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	y := []float64{2.9, 2.7, 3.8, 4.2, 4.1, 5.3, 5.2, 6.4, 6.1, 7.2}
+	σ := 0.5
+	// least squares: x̄ = 5.5, SSx = 82.5
+	xBar, ssx := 5.5, 82.5
+	yBar, sxy := 0.0, 0.0
+	for i := range x {
+		yBar += y[i] / 10
+	}
+	for i := range x {
+		sxy += (x[i] - xBar) * (y[i] - yBar)
+	}
+	b := sxy / ssx
+
+	// vague priors: least squares estimates and their standard errors
+	const vague = 1e6
+	if m, s := RegSlopePostMean(x, y, σ, 0, vague), RegSlopePostStd(x, σ, vague); !check(m, b) || !check(s, σ/math.Sqrt(ssx)) {
+		t.Error()
+		fmt.Println(m, b, s)
+	}
+	if m, s := RegInterceptPostMean(x, y, σ, 0, vague), RegInterceptPostStd(len(x), σ, vague); !check(m, yBar) || !check(s, σ/math.Sqrt(10)) {
+		t.Error()
+		fmt.Println(m, yBar, s)
+	}
+
+	// informative priors: precision weighted means
+	mβPri, sβPri := 0.3, 0.1
+	wPri, wLik := 1/(sβPri*sβPri), ssx/(σ*σ)
+	mβ := (wPri*mβPri + wLik*b) / (wPri + wLik)
+	sβ := 1 / math.Sqrt(wPri+wLik)
+	if m, s := RegSlopePostMean(x, y, σ, mβPri, sβPri), RegSlopePostStd(x, σ, sβPri); !check(m, mβ) || !check(s, sβ) {
+		t.Error()
+		fmt.Println(m, mβ, s, sβ)
+	}
+	mαPri, sαPri := 4.0, 1.0
+	wPri, wLik = 1/(sαPri*sαPri), 10/(σ*σ)
+	mα := (wPri*mαPri + wLik*yBar) / (wPri + wLik)
+	sα := 1 / math.Sqrt(wPri+wLik)
+	if m := RegInterceptPostMean(x, y, σ, mαPri, sαPri); !check(m, mα) {
+		t.Error()
+		fmt.Println(m, mα)
+	}
+
+	// credible interval of the slope
+	lo, hi := RegSlopeCrI(x, y, σ, mβPri, sβPri, 0.05)
+	if !check(lo, mβ-1.959963985*sβ) || !check(hi, mβ+1.959963985*sβ) {
+		t.Error()
+		fmt.Println(lo, hi, mβ, sβ)
+	}
+
+	// predictive distribution: narrowest at x̄, and never narrower than σ
+	prev := 0.0
+	for _, xNew := range []float64{5.5, 7, 10, 15, 30} {
+		μ, sd := RegPred(x, y, σ, mαPri, sαPri, mβPri, sβPri, xNew)
+		d := xNew - xBar
+		if !check(μ, mα+mβ*d) || !check(sd, math.Sqrt(sα*sα+d*d*sβ*sβ+σ*σ)) || !(sd > prev && sd > σ) {
+			t.Error()
+			fmt.Println(xNew, μ, sd)
+		}
+		prev = sd
+		lo, hi := RegPredCrI(x, y, σ, mαPri, sαPri, mβPri, sβPri, xNew, 0.05)
+		if !check(hi-lo, 2*1.959963985*sd) || !check((lo+hi)/2, μ) {
+			t.Error()
+			fmt.Println(xNew, lo, hi)
+		}
+	}
+
+	if panicMsg(func() { RegSlopePostMean(x, y[:5], σ, 0, 1) }) == "" {
+		t.Error()
+		fmt.Println("no panic for unequal lengths")
+	}
+	if panicMsg(func() { RegSlopePostMean([]float64{2, 2, 2}, []float64{1, 2, 3}, σ, 0, 1) }) == "" {
+		t.Error()
+		fmt.Println("no panic for equal x")
+	}
+}
@@ -0,0 +1,71 @@
+package bayes
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestReproducible(t *testing.T) {
+	fmt.Println("Testing reproducibility of simulations")
+	seeded := func(seed int64) *rand.Rand { return rand.New(rand.NewSource(seed)) }
+	equal := func(a, b []float64) bool {
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return len(a) == len(b)
+	}
+	posts := []func(*rand.Rand) float64{betaPost(5, 7), betaPost(6, 6), betaPost(7, 5)}
+	samplers := map[string]func(rng *rand.Rand) []float64{
+		"PoissonRatioPostSample": func(rng *rand.Rand) []float64 { return PoissonRatioPostSample(12, 5, 20, 6, 1, 0.1, 100, rng) },
+		"BinomDiffPostSample":    func(rng *rand.Rand) []float64 { return BinomDiffPostSample(7, 20, 11, 25, 1, 1, 100, rng) },
+		"PoissonLogRatioPostSample": func(rng *rand.Rand) []float64 {
+			return PoissonLogRatioPostSample(12, 5, 20, 6, 1, 0.1, 1, 0.1, 100, rng)
+		},
+		"ProbabilityBest": func(rng *rand.Rand) []float64 { return ProbabilityBest(posts, 100, rng) },
+		"ExpectedLoss":    func(rng *rand.Rand) []float64 { return []float64{ExpectedLoss(posts, 1, 100, rng)} },
+		"NormMuPriorPredictiveSample": func(rng *rand.Rand) []float64 {
+			return NormMuPriorPredictiveSample(0, 1, 2, 10, 100, rng)
+		},
+		"SimulateStoppingRule": func(rng *rand.Rand) []float64 {
+			rule := func(counts []int64) bool { return counts[len(counts)-1] > 4 }
+			n, fp := SimulateStoppingRule(2, rule, 50, 100, rng)
+			return []float64{n, fp}
+		},
+	}
+	for name, f := range samplers {
+		a, b, c := f(seeded(1)), f(seeded(1)), f(seeded(2))
+		if !equal(a, b) {
+			fmt.Println("failed: same seed, different output ", name)
+			t.Error()
+		}
+		if equal(a, c) {
+			fmt.Println("failed: different seeds, same output ", name)
+			t.Error()
+		}
+	}
+
+	// posterior means: E[λ1/λ2] = r1/(r2-1), E[π1-π2] = a1/(a1+b1) - a2/(a2+b2)
+	r := PoissonRatioPostSample(300, 10, 150, 10, 0, 0, 20000, seeded(3))
+	if m := mean(r); !check(m, 300.0/149) {
+		fmt.Println("failed: mean of ratio ", m)
+		t.Error()
+	}
+	d := BinomDiffPostSample(60, 100, 30, 100, 1, 1, 20000, seeded(3))
+	if m := mean(d); !check(m, 61.0/102-31.0/102) {
+		fmt.Println("failed: mean of difference ", m)
+		t.Error()
+	}
+	// Haldane's prior, as in binomPiDiffPost: the posterior means are the sample proportions
+	d = BinomDiffPostSample(60, 100, 30, 100, 0, 0, 20000, seeded(3))
+	if m := mean(d); !check(m, 0.6-0.3) {
+		fmt.Println("failed: mean of difference, Haldane's prior ", m)
+		t.Error()
+	}
+	if !panics(func() { BinomDiffPostSample(0, 100, 30, 100, 0, 0, 10, seeded(3)) }) {
+		fmt.Println("failed: no panic for an improper posterior")
+		t.Error()
+	}
+}
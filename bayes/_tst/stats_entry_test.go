@@ -0,0 +1,64 @@
+package bayes
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestStatsEntryPoints(t *testing.T) {
+	fmt.Println("Testing summary-statistics entry points")
+	x := []float64{12.1, 14.3, 11.8, 13.9, 15.2, 12.7, 13.3, 14.8}
+	y := []float64{11.5, 13.1, 12.0, 12.6, 14.1, 12.2, 12.5, 13.6}
+	d := make([]float64, len(x))
+	for i := range d {
+		d[i] = x[i] - y[i]
+	}
+	dbar, sd := meanSd(d)
+
+	μ1, σ1, ν1 := NormalPairedDiffPost(x, y)
+	μ2, σ2, ν2 := NormalPairedDiffPostStats(len(d), dbar, sd*sd)
+	if !check(μ1, μ2) || !check(σ1, σ2) || ν1 != ν2 {
+		fmt.Println("failed: NormalPairedDiffPost ", μ1, σ1, ν1, μ2, σ2, ν2)
+		t.Error()
+	}
+
+	// the same random stream gives the same draws from data and from statistics
+	n := len(x)
+	xbar, s := meanSd(x)
+	v := s * s
+	same := func(name string, a, b []float64) {
+		for i := range a {
+			if !check(a[i], b[i]) {
+				fmt.Println("failed: ", name, i, a[i], b[i])
+				t.Error()
+				return
+			}
+		}
+	}
+	rand.Seed(7)
+	mu1, s21 := NormPostSim(x, 1, 1, 10, 4, 50)
+	rand.Seed(7)
+	mu2, s22 := NormPostSimStats(n, xbar, v, 1, 1, 10, 4, 50)
+	same("NormPostSim μ", mu1, mu2)
+	same("NormPostSim σ²", s21, s22)
+
+	rand.Seed(7)
+	mu1, s21 = NormPostSimNoPrior(x, 50)
+	rand.Seed(7)
+	mu2, s22 = NormPostSimNoPriorStats(n, xbar, v, 50)
+	same("NormPostSimNoPrior μ", mu1, mu2)
+	same("NormPostSimNoPrior σ²", s21, s22)
+
+	rand.Seed(7)
+	m1, v1 := NormPostNoPriorNext(x)
+	rand.Seed(7)
+	m2, v2 := NormPostNoPriorNextStats(n, xbar, v)
+	same("NormPostNoPriorNext", []float64{m1, v1}, []float64{m2, v2})
+
+	rand.Seed(7)
+	m1, v1 = NormPostInfPriorNext(x, 1, 1, 10, 4)
+	rand.Seed(7)
+	m2, v2 = NormPostInfPriorNextStats(n, xbar, v, 1, 1, 10, 4)
+	same("NormPostInfPriorNext", []float64{m1, v1}, []float64{m2, v2})
+}
@@ -0,0 +1,55 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+	"testing"
+)
+
+func sumCounts(counts []int64) int64 {
+	s := iZero
+	for _, k := range counts {
+		s += k
+	}
+	return s
+}
+
+func TestSimulateStoppingRule(t *testing.T) {
+	fmt.Println("Testing SimulateStoppingRule")
+	λ0 := 2.0
+	α := 0.05
+	maxN := 100
+	reps := 400
+
+	// naive: stop as soon as the 95% credible interval (Jeffreys' prior) excludes λ0
+	naive := func(counts []int64) bool {
+		return PoissonLambdaTwoSidedTst(sumCounts(counts), int64(len(counts)), 0.5, 0, α, λ0)
+	}
+	avgN, fp := SimulateStoppingRule(λ0, naive, maxN, reps, rand.New(rand.NewSource(1)))
+	fmt.Println("naive rule: avgN, false positives: ", avgN, fp)
+	if fp < 2*α {
+		fmt.Println("failed: naive peeking does not inflate false positives ", fp)
+		t.Error()
+	}
+
+	// e-value: Bayes factor of Gamma(r, v) mixture against λ0, a nonnegative martingale under H0;
+	// by Ville's inequality, P(sup E >= 1/α) <= α
+	r, v := 2.0, 1.0
+	eValue := func(counts []int64) bool {
+		s := float64(sumCounts(counts))
+		n := float64(len(counts))
+		lnE := r*log(v) - LnΓ(r) + LnΓ(r+s) - (r+s)*log(v+n) - (s*log(λ0) - n*λ0)
+		return lnE >= -log(α)
+	}
+	avgN, fp = SimulateStoppingRule(λ0, eValue, maxN, reps, rand.New(rand.NewSource(1)))
+	fmt.Println("e-value rule: avgN, false positives: ", avgN, fp)
+	if fp > α+0.03 {
+		fmt.Println("failed: e-value rule inflates false positives ", fp)
+		t.Error()
+	}
+	if avgN <= 0 || avgN > float64(maxN) {
+		fmt.Println("failed: avgN out of range ", avgN)
+		t.Error()
+	}
+}
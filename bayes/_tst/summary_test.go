@@ -0,0 +1,45 @@
+package bayes
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"testing"
+)
+
+func TestSummarizeSamples(t *testing.T) {
+	fmt.Println("Testing SummarizeSamples")
+	μ, σ := 3.0, 2.0
+	x := make([]float64, 200000)
+	for i := range x {
+		x[i] = NormalNext(μ, σ)
+	}
+	first := x[0]
+	s := SummarizeSamples(x, 0.05)
+	if x[0] != first {
+		fmt.Println("failed: sample modified")
+		t.Error()
+	}
+	if s.N != len(x) || abs(s.Mean-μ) > 0.03 || abs(s.SD-σ) > 0.03 || abs(s.Median-μ) > 0.03 || abs(s.Skewness) > 0.03 {
+		fmt.Println("failed: ", s)
+		t.Error()
+	}
+	if abs(s.Q1-NormalQtlFor(μ, σ, 0.25)) > 0.05 || abs(s.Q3-NormalQtlFor(μ, σ, 0.75)) > 0.05 {
+		fmt.Println("failed: quartiles ", s.Q1, s.Q3)
+		t.Error()
+	}
+	if abs(s.CrILo-NormalQtlFor(μ, σ, 0.025)) > 0.05 || abs(s.CrIHi-NormalQtlFor(μ, σ, 0.975)) > 0.05 {
+		fmt.Println("failed: interval ", s.CrILo, s.CrIHi)
+		t.Error()
+	}
+	if s.CrILo != EmpiricalQuantile(x, 0.025) || s.CrIHi != EmpiricalQuantile(x, 0.975) {
+		fmt.Println("failed: interval differs from EmpiricalQuantile ", s.CrILo, s.CrIHi)
+		t.Error()
+	}
+
+	// R: quantile(c(4, 1, 3, 2, 5), c(0.1, 0.5, 0.9)) = 1.4 3.0 4.6
+	y := []float64{4, 1, 3, 2, 5}
+	if !check(EmpiricalQuantile(y, 0.1), 1.4) || EmpiricalQuantile(y, 0.5) != 3 || !check(EmpiricalQuantile(y, 0.9), 4.6) {
+		fmt.Println("failed: EmpiricalQuantile")
+		t.Error()
+	}
+}
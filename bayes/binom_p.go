@@ -47,7 +47,7 @@ func BinomPiPDFHPri(k, n int64) func(x float64) float64 {
 
 // BinomPiPDFBPri returns posterior PDF of the Binomial proportion, general Beta prior.
 func BinomPiPDFBPri(k, n int64, α, β float64) func(x float64) float64 {
-	if k > n {
+	if k < 0 || k > n {
 		panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
 	}
 	if α < 0 || β < 0 {
@@ -56,6 +56,18 @@ func BinomPiPDFBPri(k, n int64, α, β float64) func(x float64) float64 {
 	return dst.BetaPDF(α+float64(k), β+float64(n-k))
 }
 
+// BinomPiPostBPri returns the posterior of the Binomial proportion, general Beta prior, as a BetaDist.
+// It can be fed back as the prior for the next batch of data.
+func BinomPiPostBPri(k, n int64, α, β float64) dst.BetaDist {
+	if k < 0 || k > n {
+		panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
+	}
+	if α < 0 || β < 0 {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+	}
+	return dst.BetaDist{A: α + float64(k), B: β + float64(n-k)}
+}
+
 // BinomPiCDFFPri returns posterior CDF of the Binomial proportion, Flat prior.
 func BinomPiCDFFPri(k, n int64) func(x float64) float64 {
 	if k > n {
@@ -169,8 +181,10 @@ func BinomPiPostMean(α, β float64, n, k int64) float64 {
 
 // BinomPiPostMedian returns Posterior median of the Binomial proportion.
 func BinomPiPostMedian(α, β float64, n, k int64) float64 {
-	// TO BE IMPLEMENTED
-	return 0 // just to make compiler happy
+	var postα, postβ float64
+	postα = α + float64(k)
+	postβ = β + float64(n-k)
+	return dst.BetaQtlFor(postα, postβ, 0.5)
 }
 
 // BinomPiPostVar returns Posterior variance of the Binomial proportion.
@@ -217,11 +231,58 @@ func BinomPiCrIBP(α, β, alpha float64, n, k int64) (low, upp float64) {
 	// β - beta prior b
 	// alpha - posterior probability that the true proportion lies outside the credible interval
 
-	low = dst.BetaQtlFor(alpha/2.0, α+float64(k), β+float64(n-k))
-	upp = dst.BetaQtlFor(1.0-alpha/2.0, α+float64(k), β+float64(n-k))
+	αLo, αHi := TailsFromConfidence(1 - alpha)
+	low = dst.BetaQtlFor(α+float64(k), β+float64(n-k), αLo)
+	upp = dst.BetaQtlFor(α+float64(k), β+float64(n-k), αHi)
 	return
 }
 
+// BinomPiCrIBPri returns the equal tail area credible interval of the Binomial proportion, beta prior.
+// It is BinomPiCrIBP with the arguments in the order of BinomPiPDFBPri.
+// Bolstad 2007 (2e): 153
+func BinomPiCrIBPri(k, n int64, α, β, alpha float64) (lo, hi float64) {
+	// k		observed successes
+	// n		total number of observations
+	// α		beta prior a
+	// β		beta prior b
+	// alpha		posterior probability that the true proportion lies outside the credible interval
+	if k < 0 || k > n {
+		panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
+	}
+	if α < 0 || β < 0 {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+	}
+	return BinomPiCrIBP(α, β, alpha, n, k)
+}
+
+// BinomPiCrIFPri returns the equal tail area credible interval of the Binomial proportion, Flat prior.
+func BinomPiCrIFPri(k, n int64, alpha float64) (lo, hi float64) {
+	return BinomPiCrIBPri(k, n, 1, 1, alpha)
+}
+
+// BinomPiCrIJPri returns the equal tail area credible interval of the Binomial proportion, Jeffreys prior.
+// see Aitkin 2010: 143 for cautions
+func BinomPiCrIJPri(k, n int64, alpha float64) (lo, hi float64) {
+	return BinomPiCrIBPri(k, n, 0.5, 0.5, alpha)
+}
+
+// BinomPiOneSidedTst tests H0: π <= π0 vs H1: π > π0 for the Binomial proportion, beta prior;
+// it rejects H0 if its posterior probability is less than alpha.
+// Bolstad 2007 (2e): Chapter 9.
+// Note: The alternative is in the direction we wish to detect.
+func BinomPiOneSidedTst(k, n int64, α, β, alpha, π0 float64) bool {
+	cdf := BinomPiCDFBPri(k, n, α, β)
+	return cdf(π0) < alpha
+}
+
+// BinomPiTwoSidedTst tests H0: π = π0 vs H1: π != π0 for the Binomial proportion, beta prior;
+// it rejects H0 if π0 lies outside the (1-alpha) credible interval.
+// Bolstad 2007 (2e): Chapter 9.
+func BinomPiTwoSidedTst(k, n int64, α, β, alpha, π0 float64) bool {
+	lo, hi := BinomPiCrIBPri(k, n, α, β, alpha)
+	return π0 < lo || π0 > hi
+}
+
 // BinomPiCrIBPriNApprox returns boundaries of the credible interval of theBinomial proportion, beta prior, equal tail area, normal approximation,
 // Bolstad 2007 (2e): 154-155, eq. 8.8
 // untested ...
@@ -243,9 +304,10 @@ func BinomPiCrIBPriNApprox(α, β, alpha float64, n, k int64) (low, upp float64)
 
 	postmean = postα / (postα + postβ)
 	postvar = (postα * postβ) / ((postα + postβ) * (postα + postβ) * (postα + postβ + 1.0))
-	z = dst.ZQtlFor(alpha / 2)
+	αLo, αHi := TailsFromConfidence(1 - alpha)
+	z = dst.ZQtlFor(αHi)
 
-	low = postmean - z*math.Sqrt(postvar)
+	low = postmean + dst.ZQtlFor(αLo)*math.Sqrt(postvar)
 	upp = postmean + z*math.Sqrt(postvar)
 	return low, upp
 }
@@ -260,8 +322,8 @@ func BinomPiDeviance(pi float64, n, k int64) float64 {
 	return -2 * math.Log(BinomPiLike(pi, n, k))
 }
 
-// Binomial proportion, Sampling from posterior, Beta prior
-func BinomPiCDFBPriNext(k, n int64, α, β float64) float64 {
+// BinomPiNextBPri returns random number drawn from the posterior of the Binomial proportion, Beta prior.
+func BinomPiNextBPri(k, n int64, α, β float64) float64 {
 	if k > n {
 		panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
 	}
@@ -271,6 +333,21 @@ func BinomPiCDFBPriNext(k, n int64, α, β float64) float64 {
 	return dst.BetaNext(α+float64(k), β+float64(n-k))
 }
 
+// BinomPiNextFPri returns random number drawn from the posterior of the Binomial proportion, Flat prior.
+func BinomPiNextFPri(k, n int64) float64 {
+	return BinomPiNextBPri(k, n, 1, 1)
+}
+
+// BinomPiNextJPri returns random number drawn from the posterior of the Binomial proportion, Jeffreys prior.
+func BinomPiNextJPri(k, n int64) float64 {
+	return BinomPiNextBPri(k, n, 0.5, 0.5)
+}
+
+// BinomPiCDFBPriNext is the former name of BinomPiNextBPri, kept for compatibility.
+func BinomPiCDFBPriNext(k, n int64, α, β float64) float64 {
+	return BinomPiNextBPri(k, n, α, β)
+}
+
 // Binomial proportion, Deviance difference of a point null hypothesis pi = p against general alternative pi != p
 // Aitkin 2010:143-144.
 func binomPiPointDevDiff(k, n int64, α, β, p, pi float64) float64 {
@@ -0,0 +1,63 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Bayesian inference about the Binomial proportion from data aggregated over several strata.
+// Successes and trials are sufficient statistics, so pooled strata update the Beta prior once with the totals.
+// Pooling assumes a common proportion; Pearson's ChiSquare test of homogeneity checks it.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+)
+
+// BinomPiPostAggregated returns parameters of the pooled Beta posterior of the Binomial proportion from (successes, trials) rows,
+// and Pearson's ChiSquare test of homogeneity of the proportion across rows. Rows with no trials carry no information
+// about the proportion and are left out of the test.
+func BinomPiPostAggregated(successes, trials []int64, α, β float64) (αPost, βPost, chi2, pVal float64) {
+	// Arguments:
+	// successes - number of successes in each stratum
+	// trials - number of trials in each stratum
+	// α, β - parameters of the Beta prior
+	//
+	// Returns:
+	// αPost, βPost - parameters of the Beta posterior given the pooled counts
+	// chi2 - ChiSquare statistic with one degree of freedom less than the number of rows with trials
+	// pVal - the p-value of the test of homogeneity; small values mean the strata should not be pooled
+	if len(successes) != len(trials) || len(trials) == 0 {
+		panic(fmt.Sprintf("successes and trials must be nonempty and of the same length"))
+	}
+	if α < 0 || β < 0 {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+	}
+	var k, n int64
+	rows := 0
+	for i := range trials {
+		if successes[i] < 0 || successes[i] > trials[i] {
+			panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
+		}
+		k += successes[i]
+		n += trials[i]
+		if trials[i] > 0 {
+			rows++
+		}
+	}
+	αPost = α + float64(k)
+	βPost = β + float64(n-k)
+
+	pVal = 1
+	if rows < 2 || k == 0 || k == n {
+		return
+	}
+	p := float64(k) / float64(n)
+	for i := range trials {
+		if trials[i] == 0 {
+			continue
+		}
+		e := float64(trials[i]) * p
+		d := float64(successes[i]) - e
+		chi2 += d * d / (e * (1 - p))
+	}
+	pVal = 1 - dst.ChiSquareCDFAt(int64(rows-1), chi2)
+	return
+}
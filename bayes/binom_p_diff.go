@@ -9,11 +9,12 @@
 // Approximate each posterior distribution with normal distribution having the same mean and variance as the beta.
 // The posterior of pid = pi1 - pi2 is approximately normal(mdpost, vardpost), where:
 // mdpost = a1post/(a1post+b1post) - a2post/(a2post+b2post), and
-// vardpost = a1post*b1post/math.Sqrt(a1post+b1post)*(a1post+b1post+1)  +  a2post*b2post/math.Sqrt(a2post+b2post)*(a2post+b2post+1) 
+// vardpost = a1post*b1post/((a1post+b1post)²*(a1post+b1post+1))  +  a2post*b2post/((a2post+b2post)²*(a2post+b2post+1))
 
 package bayes
 
 import (
+	"fmt"
 	. "github.com/datastream/probab/dst"
 	"math"
 )
@@ -41,7 +42,9 @@ func BinomPiDiffVarNApprox(a1, b1, a2, b2 float64, n1, n2, y1, y2 int64) float64
 	a2post := a2 + float64(y2)
 	b2post := b2 + float64(n2-y2)
 
-	return a1post*b1post/math.Sqrt(a1post+b1post)*(a1post+b1post+1) + a2post*b2post/math.Sqrt(a2post+b2post)*(a2post+b2post+1)
+	s1 := a1post + b1post
+	s2 := a2post + b2post
+	return a1post*b1post/(s1*s1*(s1+1)) + a2post*b2post/(s2*s2*(s2+1))
 }
 
 // Credible interval for difference between binomial proportions, approximated by Normal distribution
@@ -54,9 +57,9 @@ func BinomPiDiffCrI(postdiffmu, postdiffsigma, alpha float64) (float64, float64)
 	// postdiffsigma	posterior standard deviation for difference of normal means
 	// alpha			posterior probability that the true mean lies outside the credible interval
 
-	z := ZQtlFor(alpha / 2)
-	low := postdiffmu - z*postdiffsigma
-	high := postdiffmu + z*postdiffsigma
+	αLo, αHi := TailsFromConfidence(1 - alpha)
+	low := postdiffmu + ZQtlFor(αLo)*postdiffsigma
+	high := postdiffmu + ZQtlFor(αHi)*postdiffsigma
 	return low, high
 }
 
@@ -79,3 +82,55 @@ func BinomDiffPropTwoSidedProb(postdiffmu, postdiffsigma, alpha){
 	if 0 < low || 0 > high return(REJECT) else return(ACCEPT)
 }
 */
+
+// binomPiDiffPost checks the data and priors of both groups, and returns the parameters of the Beta posteriors.
+func binomPiDiffPost(y1, n1, y2, n2 int64, a1, b1, a2, b2 float64) (a1post, b1post, a2post, b2post float64) {
+	if y1 < 0 || y1 > n1 || y2 < 0 || y2 > n2 {
+		panic(fmt.Sprintf("The number of observed successes (y) must be between 0 and the number of trials (n)"))
+	}
+	if !(a1 >= 0 && b1 >= 0 && a2 >= 0 && b2 >= 0) {
+		panic(fmt.Sprintf("The parameters of the priors must be non-negative"))
+	}
+	a1post, b1post, a2post, b2post = a1+float64(y1), b1+float64(n1-y1), a2+float64(y2), b2+float64(n2-y2)
+	if !(a1post > 0 && b1post > 0 && a2post > 0 && b2post > 0) {
+		panic(fmt.Sprintf("posterior is improper: a prior parameter of zero needs at least one success and one failure"))
+	}
+	return
+}
+
+// BinomPiDiffCrIBPri returns the equal tail area credible interval for π1 - π2, beta priors,
+// approximating the posterior of the difference by the Normal distribution.
+// Bolstad 2007 (2e): 248, eq. 13.13
+func BinomPiDiffCrIBPri(y1, n1, y2, n2 int64, a1, b1, a2, b2, α float64) (lo, hi float64) {
+	// y1, n1	successes and trials, first group
+	// y2, n2	successes and trials, second group
+	// a1, b1	beta prior of π1
+	// a2, b2	beta prior of π2
+	// α		posterior probability that the true difference lies outside the credible interval
+	binomPiDiffPost(y1, n1, y2, n2, a1, b1, a2, b2)
+	μ := BinomPiDiffMeanNApprox(a1, b1, a2, b2, n1, n2, y1, y2)
+	σ := math.Sqrt(BinomPiDiffVarNApprox(a1, b1, a2, b2, n1, n2, y1, y2))
+	return BinomPiDiffCrI(μ, σ, α)
+}
+
+// BinomPiDiffNextBPri returns random π1 - π2 drawn from the posterior, beta priors,
+// drawing π1 and π2 from their Beta posteriors. The sample is exact, not a Normal approximation.
+func BinomPiDiffNextBPri(y1, n1, y2, n2 int64, a1, b1, a2, b2 float64) float64 {
+	a1post, b1post, a2post, b2post := binomPiDiffPost(y1, n1, y2, n2, a1, b1, a2, b2)
+	return BetaNext(a1post, b1post) - BetaNext(a2post, b2post)
+}
+
+// BinomPiDiffProbGreaterBPri returns the posterior probability P(π1 > π2), beta priors.
+// It is the integral of F2(Q1(u)) over u in (0, 1), where Q1 is the quantile function of the posterior of π1,
+// and F2 the CDF of the posterior of π2, computed by the midpoint rule; the error is of the order of 1e-5.
+func BinomPiDiffProbGreaterBPri(y1, n1, y2, n2 int64, a1, b1, a2, b2 float64) float64 {
+	a1post, b1post, a2post, b2post := binomPiDiffPost(y1, n1, y2, n2, a1, b1, a2, b2)
+	const m = 2000
+	qtl := BetaQtl(a1post, b1post)
+	cdf := BetaCDF(a2post, b2post)
+	p := 0.0
+	for i := 0; i < m; i++ {
+		p += cdf(qtl((float64(i) + 0.5) / m))
+	}
+	return p / m
+}
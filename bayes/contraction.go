@@ -0,0 +1,48 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Posterior contraction: the proportion of prior uncertainty removed by the data.
+// Ref.: Betancourt, M. (2018): Calibrating model-based inferences and decisions. arXiv:1803.08393.
+
+import (
+	"fmt"
+)
+
+// ContractionReport returns the posterior contraction 1 - posteriorStd/priorStd.
+// It is 0 when the data add nothing, approaches 1 as the posterior concentrates, and is negative
+// when the posterior is wider than the prior (prior-data conflict).
+func ContractionReport(priorStd, posteriorStd float64) float64 {
+	if priorStd <= 0 || posteriorStd < 0 {
+		panic(fmt.Sprintf("standard deviations must be positive"))
+	}
+	return 1 - posteriorStd/priorStd
+}
+
+// PoissonLambdaContraction returns the posterior contraction of Poisson rate λ, gamma prior.
+func PoissonLambdaContraction(sumK, n int64, r, v float64) float64 {
+	// sumK, n	total observed events in n equal time intervals
+	// r, v		shape and rate of the gamma prior
+	if sumK < 0 || n < 0 {
+		panic("bad data")
+	}
+	if r <= 0 || v <= 0 {
+		panic(fmt.Sprintf("Shape parameter r and rate parameter v must be greater than zero"))
+	}
+	priorStd := sqrt(r) / v
+	postStd := sqrt(r+float64(sumK)) / (v + float64(n))
+	return ContractionReport(priorStd, postStd)
+}
+
+// NormMuContraction returns the posterior contraction of Normal μ, with KNOWN σ, and Normal prior.
+func NormMuContraction(nObs int, σ, σPri float64) float64 {
+	// nObs		number of observations
+	// σ		standard deviation of population, assumed to be known
+	// σPri		prior standard deviation
+	if nObs < 0 {
+		panic("bad data")
+	}
+	checkNormσ(σ)
+	checkNormσPri(σPri)
+	return ContractionReport(σPri, NormMuPostStd(nObs, σ, 0, σPri))
+}
@@ -0,0 +1,84 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Bayesian inference about the correlation coefficient ρ of bivariate Normal distribution.
+// With the reference prior g(ρ) ∝ 1/(1-ρ²), Jeffreys' approximation to the likelihood gives the posterior
+// p(ρ | r) ∝ (1-ρ²)^((n-3)/2) (1-ρr)^-(n-3/2),
+// r sample correlation coefficient, n number of pairs.
+// Lee, P.M. 2012 (4e): Bayesian Statistics: An Introduction: 196-199.
+
+import (
+	"fmt"
+	"math"
+)
+
+// corrRhoGrid returns a grid on ζ = atanh(ρ), the posterior density of ζ on the grid, normalized, and its CDF.
+func corrRhoGrid(r float64, n int) (ζ, pdf, cdf []float64) {
+	const m = 4001
+	if !(r > -1 && r < 1) {
+		panic(fmt.Sprintf("sample correlation r must be in (-1, 1)"))
+	}
+	if n < 4 {
+		panic(fmt.Sprintf("number of pairs n must be at least 4"))
+	}
+	nn := float64(n)
+	ζHat := math.Atanh(r)
+	w := 12 / sqrt(nn-3)
+	h := 2 * w / (m - 1)
+	ζ = make([]float64, m)
+	lnp := make([]float64, m)
+	lnMax := negInf
+	for i := range ζ {
+		ζ[i] = ζHat - w + float64(i)*h
+		ρ := math.Tanh(ζ[i])
+		// dρ/dζ = 1-ρ²
+		lnp[i] = (nn-1)/2*log1p(-ρ*ρ) - (nn-1.5)*log1p(-ρ*r)
+		if lnp[i] > lnMax {
+			lnMax = lnp[i]
+		}
+	}
+	pdf = make([]float64, m)
+	cdf = make([]float64, m)
+	for i := range pdf {
+		pdf[i] = exp(lnp[i] - lnMax)
+		if i > 0 {
+			cdf[i] = cdf[i-1] + (pdf[i]+pdf[i-1])*h/2
+		}
+	}
+	total := cdf[m-1]
+	for i := range pdf {
+		pdf[i] /= total
+		cdf[i] /= total
+	}
+	return
+}
+
+// CorrRhoPDFRPri returns the posterior PDF of the correlation coefficient ρ, reference prior.
+func CorrRhoPDFRPri(r float64, n int) func(ρ float64) float64 {
+	// r	sample correlation coefficient
+	// n	number of pairs
+	ζ, pdf, _ := corrRhoGrid(r, n)
+	return func(ρ float64) float64 {
+		if ρ <= -1 || ρ >= 1 {
+			return 0
+		}
+		p := linInt(ζ, pdf, math.Atanh(ρ))
+		if isNaN(p) { // outside the grid
+			return 0
+		}
+		return p / (1 - ρ*ρ)
+	}
+}
+
+// CorrRhoCrIRPri returns the equal tail credible interval of the correlation coefficient ρ, reference prior.
+func CorrRhoCrIRPri(r float64, n int, α float64) (lo, hi float64) {
+	// r	sample correlation coefficient
+	// n	number of pairs
+	// α	posterior probability that ρ lies outside the credible interval
+	αLo, αHi := TailsFromConfidence(1 - α)
+	ζ, _, cdf := corrRhoGrid(r, n)
+	lo = math.Tanh(linInt(cdf, ζ, αLo))
+	hi = math.Tanh(linInt(cdf, ζ, αHi))
+	return
+}
@@ -1,26 +1,85 @@
-// Bayesian credible interval. 
+// Bayesian credible interval.
+//
+// All interval functions in this package take α as the total posterior probability
+// that the parameter lies outside the interval, split equally between the tails:
+// α = 0.05 gives the 2.5% and 97.5% posterior quantiles.
 
 package bayes
 
-/*
 import (
+	"fmt"
 	. "github.com/datastream/probab/dst"
-	"math"
 )
-*/
 
-// Bayesian credible interval for (analytical) quantile function 
-func CrI(α float64, qtl func(𝛩 float64) float64) (hi, lo float64) {
-	p := (1 - α)
-	lo = qtl(p / 2)
-	hi = qtl(1 - p/2)
+// TailsFromConfidence returns the lower and upper tail probabilities of the equal tail interval with given confidence (credibility) level.
+func TailsFromConfidence(conf float64) (αLo, αHi float64) {
+	if !(conf > 0 && conf < 1) {
+		panic(fmt.Sprintf("confidence level must be in (0, 1)"))
+	}
+	αLo = (1 - conf) / 2
+	αHi = 1 - αLo
+	return
+}
+
+// checkα panics unless the tail probability α is in (0, 1).
+func checkα(α float64) {
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+}
+
+// Bayesian credible interval for (analytical) quantile function
+func CrI(α float64, qtl func(𝛩 float64) float64) (lo, hi float64) {
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = qtl(αLo)
+	hi = qtl(αHi)
 	return
 }
 
 // Credible interval for a sample from a posterior density
 func ECrI(𝛩 []float64, α float64) (lo, hi float64) {
-	p := (1 - α)
-	lo = eQtl(𝛩, p/2)
-	hi = eQtl(𝛩, 1-p/2)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = eQtl(𝛩, αLo)
+	hi = eQtl(𝛩, αHi)
 	return
 }
+
+// HPDInterval returns the highest posterior density interval, the shortest interval with posterior probability 1-α,
+// for a unimodal posterior with density pdf and quantile function qtl. Unlike the equal tail interval, it puts
+// unequal probability into the two tails when the posterior is skewed.
+// The lower tail probability p is the root of pdf(qtl(p)) = pdf(qtl(p+1-α)), found by FindRoot; if the density is
+// monotone over the interval, one limit is the end of the support.
+func HPDInterval(pdf, qtl func(float64) float64, α float64) (lo, hi float64) {
+	// Arguments:
+	// pdf		posterior density
+	// qtl		posterior quantile function
+	// α		posterior probability that the true value lies outside the interval
+	checkα(α)
+	// density at x, zero at an infinite end of the support
+	dens := func(x float64) float64 {
+		if isInf(x, 0) {
+			return 0
+		}
+		return pdf(x)
+	}
+	// diff is negative while the lower limit is too far left, positive once it is too far right
+	diff := func(p float64) float64 {
+		return dens(qtl(p)) - dens(qtl(p+1-α))
+	}
+	a, b := 0.0, α
+	switch {
+	case diff(a) >= 0: // density decreasing: interval starts at the lower end of the support
+		b = a
+	case diff(b) <= 0: // density increasing: interval ends at the upper end of the support
+		a = b
+	}
+	p := a
+	if a < b {
+		var err error
+		p, err = FindRoot(diff, a, b, 1e-12*α)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return qtl(p), qtl(p + 1 - α)
+}
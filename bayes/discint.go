@@ -50,6 +50,8 @@ func DiscHPI(x, p []float64, probContent float64) (probExact float64, hpiSet []f
 	// Returns:
 	// probExact - exact probability content of the HPI
 	// hpiSet set of values of x within the highest probability interval
+	checkFinite("x", x...)
+	checkFinite("p", p...)
 	s := NewSorter(p)
 	sort.Sort(s)
 	ix := s.Indices
@@ -1,4 +1,8 @@
 // Copyright 2012 The Probab Authors. All rights reserved. See the LICENSE file.
 
 // Bayesian inference. 
+//
+// Functions that simulate take a source of randomness rng *rand.Rand as their last argument.
+// If rng is nil, a freshly seeded source is used; passing rand.New(rand.NewSource(seed))
+// makes the result reproducible: the same seed yields bit-identical output.
 package bayes
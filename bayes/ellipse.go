@@ -0,0 +1,45 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Joint credible region of two parameters with Bivariate Normal posterior.
+// The region {x: (x-μ)'Σ⁻¹(x-μ) <= χ²(2, conf)} holds posterior probability conf;
+// its boundary is the image of a circle under the Cholesky factor of Σ.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+)
+
+// number of boundary points returned by CredibleEllipse
+const ellipsePoints = 360
+
+// CredibleEllipse returns points on the boundary of the joint credible region with posterior probability conf,
+// for two parameters with Bivariate Normal posterior.
+func CredibleEllipse(μ1, μ2, σ1, σ2, ρ, conf float64) (points [][2]float64) {
+	// μ1, μ2	posterior means
+	// σ1, σ2	posterior standard deviations
+	// ρ		posterior correlation
+	// conf		posterior probability inside the region
+	checkFinite("posterior parameters", μ1, μ2, σ1, σ2, ρ)
+	if σ1 <= 0 || σ2 <= 0 {
+		panic(fmt.Sprintf("standard deviations must be positive"))
+	}
+	if ρ <= -1 || ρ >= 1 {
+		panic(fmt.Sprintf("ρ must be in (-1, 1)"))
+	}
+	if !(conf > 0 && conf < 1) {
+		panic(fmt.Sprintf("conf must be in (0, 1)"))
+	}
+	r := math.Sqrt(ChiSquareQtlFor(2, conf))
+	sρ := math.Sqrt(1 - ρ*ρ)
+	points = make([][2]float64, ellipsePoints)
+	for i := range points {
+		t := 2 * π * float64(i) / ellipsePoints
+		c, s := math.Cos(t), math.Sin(t)
+		points[i][0] = μ1 + r*σ1*c
+		points[i][1] = μ2 + r*σ2*(ρ*c+sρ*s)
+	}
+	return
+}
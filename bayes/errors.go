@@ -0,0 +1,104 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Error-returning variants of functions that panic on bad arguments, for callers such as servers
+// that pass user input through and must not go down with it. Each XxxE function calls Xxx and turns
+// the panic of a failed argument check into an error with the same message. Runtime errors
+// (index out of range, nil function, ...) are bugs, not bad input, and still panic.
+
+import (
+	"errors"
+	"runtime"
+)
+
+// recoverE, deferred, stores the panic of a failed argument check in *err.
+func recoverE(err *error) {
+	r := recover()
+	switch e := r.(type) {
+	case nil:
+	case runtime.Error:
+		panic(e)
+	case error:
+		*err = e
+	case string:
+		*err = errors.New(e)
+	default:
+		panic(r)
+	}
+}
+
+// PoissonLambdaPDFGPriE is PoissonLambdaPDFGPri, returning an error for bad arguments:
+// sumK >= 0, n > 0, r >= 0 and v >= 0.
+func PoissonLambdaPDFGPriE(sumK, n int64, r, v float64) (pdf func(float64) float64, err error) {
+	defer recoverE(&err)
+	return PoissonLambdaPDFGPri(sumK, n, r, v), nil
+}
+
+// PoissonLambdaCDFGPriE is PoissonLambdaCDFGPri, returning an error for bad arguments:
+// sumK >= 0, n > 0, r >= 0 and v >= 0.
+func PoissonLambdaCDFGPriE(sumK, n int64, r, v float64) (cdf func(float64) float64, err error) {
+	defer recoverE(&err)
+	return PoissonLambdaCDFGPri(sumK, n, r, v), nil
+}
+
+// PoissonLambdaQtlGPriE is PoissonLambdaQtlGPri, returning an error for bad arguments:
+// sumK >= 0, n > 0, r >= 0 and v >= 0.
+func PoissonLambdaQtlGPriE(sumK, n int64, r, v float64) (qtl func(float64) float64, err error) {
+	defer recoverE(&err)
+	return PoissonLambdaQtlGPri(sumK, n, r, v), nil
+}
+
+// PoissonLambdaCrIGPriE is PoissonLambdaCrIGPri, returning an error for bad arguments:
+// sumK >= 0, n > 0, r >= 0, v >= 0, and α in (0, 1).
+func PoissonLambdaCrIGPriE(sumK, n int64, r, v, α float64) (lo, hi float64, err error) {
+	defer recoverE(&err)
+	lo, hi = PoissonLambdaCrIGPri(sumK, n, r, v, α)
+	return
+}
+
+// NormMuSinglePMFDPriE is NormMuSinglePMFDPri, returning an error for bad arguments:
+// y finite, σ > 0, and μPri of the same length as μ, with masses non-negative, finite, and not all zero.
+func NormMuSinglePMFDPriE(y, σ float64, μ []float64, μPri []float64) (post []float64, err error) {
+	defer recoverE(&err)
+	return NormMuSinglePMFDPri(y, σ, μ, μPri), nil
+}
+
+// NormMuPMFDPriE is NormMuPMFDPri, returning an error for bad arguments:
+// nObs >= 0, ȳ finite, σ > 0, and μPri of the same length as μ, with masses non-negative, finite, and not all zero.
+func NormMuPMFDPriE(nObs int, ȳ, σ float64, μ []float64, μPri []float64) (post []float64, err error) {
+	defer recoverE(&err)
+	return NormMuPMFDPri(nObs, ȳ, σ, μ, μPri), nil
+}
+
+// NormMuQtlFPriE is NormMuQtlFPri, returning an error for bad arguments: nObs >= 1 and σ > 0.
+func NormMuQtlFPriE(nObs int, ȳ, σ, p float64) (q float64, err error) {
+	defer recoverE(&err)
+	return NormMuQtlFPri(nObs, ȳ, σ, p), nil
+}
+
+// NormMuQtlNPriE is NormMuQtlNPri, returning an error for bad arguments: nObs >= 0, σ > 0 and σPri > 0.
+func NormMuQtlNPriE(nObs int, ȳ, σ, μPri, σPri, p float64) (q float64, err error) {
+	defer recoverE(&err)
+	return NormMuQtlNPri(nObs, ȳ, σ, μPri, σPri, p), nil
+}
+
+// NormMuCrINPriKnownE is NormMuCrINPriKnown, returning an error for bad arguments: nObs >= 0, σ > 0, σPri > 0, and α in (0, 1).
+func NormMuCrINPriKnownE(nObs int, ȳ, σ, μPri, σPri, α float64) (lo, hi float64, err error) {
+	defer recoverE(&err)
+	lo, hi = NormMuCrINPriKnown(nObs, ȳ, σ, μPri, σPri, α)
+	return
+}
+
+// BinomPiPDFBPriE is BinomPiPDFBPri, returning an error for bad arguments: 0 <= k <= n, α >= 0 and β >= 0.
+func BinomPiPDFBPriE(k, n int64, α, β float64) (pdf func(float64) float64, err error) {
+	defer recoverE(&err)
+	return BinomPiPDFBPri(k, n, α, β), nil
+}
+
+// BinomPiCrIBPriE is BinomPiCrIBPri, returning an error for bad arguments: 0 <= k <= n, α >= 0, β >= 0, and alpha in (0, 1).
+func BinomPiCrIBPriE(k, n int64, α, β, alpha float64) (lo, hi float64, err error) {
+	defer recoverE(&err)
+	lo, hi = BinomPiCrIBPri(k, n, α, β, alpha)
+	return
+}
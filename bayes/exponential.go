@@ -0,0 +1,57 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Bayesian inference about the rate λ of Exponential distribution, gamma prior.
+// The gamma(r, v) prior (v is a RATE) is conjugate: after n observed waiting times with total sumT,
+// the posterior is gamma(r+n, v+sumT). The roles of count and exposure are swapped against the Poisson case.
+// Use r=m^2/s^2, and v=m/s^2, if you summarize your prior belief with mean == m, and std == s.
+// For the flat prior use r = 1, v = 0; for Jeffreys' prior g(λ) ∝ 1/λ use r = 0, v = 0.
+
+import (
+	"fmt"
+)
+
+// expRatePost returns the gamma posterior of the Exponential rate, checking the arguments.
+func expRatePost(sumT float64, n int64, r, v float64) GammaPrior {
+	if !(sumT >= 0) || isInf(sumT, 0) || n < 0 {
+		panic("bad data")
+	}
+	if r < 0 || v < 0 {
+		panic(fmt.Sprintf("Shape parameter r and rate parameter v must be greater than or equal to zero"))
+	}
+	post := GammaPrior{r + float64(n), v + sumT}
+	if post.R <= 0 || post.V <= 0 {
+		panic(fmt.Sprintf("posterior is improper: no events, or no observed time, and an improper prior"))
+	}
+	return post
+}
+
+// ExpRatePDFGPri returns the posterior PDF of the Exponential rate, gamma prior.
+func ExpRatePDFGPri(sumT float64, n int64, r, v float64) func(x float64) float64 {
+	// sumT		total observed time
+	// n		number of observed events (waiting times)
+	// r, v		shape and rate of the gamma prior
+	return expRatePost(sumT, n, r, v).PDF()
+}
+
+// ExpRateCDFGPri returns the posterior CDF of the Exponential rate, gamma prior.
+func ExpRateCDFGPri(sumT float64, n int64, r, v float64) func(x float64) float64 {
+	return expRatePost(sumT, n, r, v).CDF()
+}
+
+// ExpRateQtlGPri returns the posterior quantile function of the Exponential rate, gamma prior.
+func ExpRateQtlGPri(sumT float64, n int64, r, v float64) func(p float64) float64 {
+	return expRatePost(sumT, n, r, v).Qtl()
+}
+
+// ExpRatePostMean returns the posterior mean (r+n)/(v+sumT) of the Exponential rate, gamma prior.
+func ExpRatePostMean(sumT float64, n int64, r, v float64) float64 {
+	return expRatePost(sumT, n, r, v).Mean()
+}
+
+// ExpRateCrIGPri returns the equal tail area credible interval of the Exponential rate, gamma prior.
+func ExpRateCrIGPri(sumT float64, n int64, r, v, α float64) (lo, hi float64) {
+	// α		posterior probability that the true rate lies outside the credible interval
+	return expRatePost(sumT, n, r, v).CrI(α)
+}
@@ -1,6 +1,7 @@
 package bayes
 
 import (
+	"fmt"
 	"math"
 )
 
@@ -82,6 +83,7 @@ func fmin(f func(float64) float64, ax, bx, tol float64) float64 {
 	fw = fx
 
 	for {
+		gsNeeded = false
 		xm = 0.5 * (a + b)
 		tol1 = eps*math.Abs(x) + tol/3.0
 		tol2 = 2.0 * tol1
@@ -182,3 +184,15 @@ func fmin(f func(float64) float64, ax, bx, tol float64) float64 {
 	} // for
 	return x
 }
+
+// Minimize1D returns the point x in [lo, hi] where f attains its minimum, and the minimum value fx, by Brent's method
+// (golden section search and successive parabolic interpolation, see fmin). f should be unimodal on [lo, hi];
+// otherwise a local minimum is returned. x is accurate to about 1e-8 relative to |x|, which is as good as
+// comparing function values allows near a smooth minimum. To maximize g, minimize -g.
+func Minimize1D(f func(float64) float64, lo, hi float64) (x, fx float64) {
+	if math.IsNaN(lo) || math.IsNaN(hi) || math.IsInf(lo, 0) || math.IsInf(hi, 0) || !(lo < hi) {
+		panic(fmt.Sprintf("bracket [lo, hi] must be finite, with lo < hi"))
+	}
+	x = fmin(f, lo, hi, 1e-10*(hi-lo))
+	return x, f(x)
+}
@@ -3,6 +3,7 @@
 package bayes
 
 import (
+	"fmt"
 	fn "github.com/datastream/go-fn/fn"
 	"math"
 )
@@ -61,3 +62,12 @@ func mean(x []float64) float64 {
 	μ /= float64(len(x))
 	return μ
 }
+
+// checkFinite panics if any of the values is NaN or infinite.
+func checkFinite(name string, x ...float64) {
+	for i, v := range x {
+		if isNaN(v) || isInf(v, 0) {
+			panic(fmt.Sprintf("%s must be finite, got %v at index %d", name, v, i))
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Fusion of independent Normal measurements of the same quantity, with KNOWN variances.
+// The inverse-variance weighted mean is the best linear unbiased estimate, and also the posterior mean under a flat prior.
+// Bolstad 2007 (2e): 209, eqs. 11.5 and 11.6, with σPri → ∞.
+
+import (
+	"fmt"
+)
+
+// FuseNormals returns the inverse-variance weighted mean of independent Normal measurements, and its variance.
+func FuseNormals(means, variances []float64) (fusedMean, fusedVar float64) {
+	// Arguments:
+	// means - measured values
+	// variances - their known variances
+	//
+	// Returns:
+	// fusedMean - Σ(means[i]/variances[i]) / Σ(1/variances[i])
+	// fusedVar - 1 / Σ(1/variances[i])
+	if len(means) != len(variances) || len(means) == 0 {
+		panic(fmt.Sprintf("means and variances must be nonempty and of the same length"))
+	}
+	// weights relative to the smallest variance avoid overflow of 1/v for tiny variances
+	vMin := posInf
+	for _, v := range variances {
+		if !(v > 0) || isInf(v, 1) {
+			panic(fmt.Sprintf("variances must be positive and finite"))
+		}
+		if v < vMin {
+			vMin = v
+		}
+	}
+	sw := 0.0
+	for i, v := range variances {
+		w := vMin / v
+		sw += w
+		fusedMean += w * (means[i] - means[0])
+	}
+	fusedMean = means[0] + fusedMean/sw
+	fusedVar = vMin / sw
+	return
+}
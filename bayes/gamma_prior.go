@@ -0,0 +1,74 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Gamma distribution of a rate (Poisson λ, or the Exponential rate) as a value, for conjugate updating
+// without passing (r, v) around.
+// The prior and every posterior are the same type, so updating batch by batch is a chain of Update calls.
+// Bolstad 2007 (2e): Chapter 10.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+)
+
+// GammaPrior is the gamma(R, V) distribution of a rate λ, with shape R and RATE V (1/scale).
+// For the flat prior use {1, 0}, for Jeffreys' prior {0.5, 0}; these are improper until updated.
+type GammaPrior struct {
+	R, V float64
+}
+
+func (g GammaPrior) check() {
+	if g.R < 0 || g.V < 0 {
+		panic(fmt.Sprintf("Shape parameter r and rate parameter v must be greater than or equal to zero"))
+	}
+}
+
+// Update returns the posterior of Poisson λ after sumK events in n further intervals.
+func (g GammaPrior) Update(sumK, n int64) GammaPrior {
+	r, v := PoissonLambdaUpdate(g.R, g.V, sumK, n)
+	return GammaPrior{r, v}
+}
+
+// PDF returns the PDF of λ.
+func (g GammaPrior) PDF() func(x float64) float64 {
+	g.check()
+	return GammaPDF(g.R, 1/g.V)
+}
+
+// CDF returns the CDF of λ.
+func (g GammaPrior) CDF() func(x float64) float64 {
+	g.check()
+	return GammaCDF(g.R, 1/g.V)
+}
+
+// Qtl returns the quantile function of λ.
+func (g GammaPrior) Qtl() func(p float64) float64 {
+	g.check()
+	return GammaQtl(g.R, 1/g.V)
+}
+
+// CrI returns the equal tail area credible interval of λ.
+func (g GammaPrior) CrI(α float64) (lo, hi float64) {
+	// α		probability that λ lies outside the credible interval
+	qtl := g.Qtl()
+	αLo, αHi := TailsFromConfidence(1 - α)
+	return qtl(αLo), qtl(αHi)
+}
+
+// Mean returns the mean R/V of λ.
+func (g GammaPrior) Mean() float64 {
+	g.check()
+	return g.R / g.V
+}
+
+// String returns the distribution as GammaDist formats it, e.g. "Gamma(shape=3.5, rate=2)".
+func (g GammaPrior) String() string {
+	return GammaDist{Shape: g.R, Rate: g.V}.String()
+}
+
+// Var returns the variance R/V² of λ.
+func (g GammaPrior) Var() float64 {
+	g.check()
+	return g.R / (g.V * g.V)
+}
@@ -0,0 +1,84 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Credible intervals for streaming data.
+// Conjugate updates only change the posterior hyperparameters; the quantiles are recomputed
+// lazily, when the interval is requested after an update.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+)
+
+// IncrementalCrI holds the posterior hyperparameters of a conjugate model, and caches its equal tail credible interval.
+type IncrementalCrI struct {
+	a, b   float64 // posterior hyperparameters
+	α      float64 // posterior probability outside the credible interval
+	update func(a, b *float64, x, n int64)
+	qtl    func(a, b, p float64) float64
+	lo, hi float64
+	stale  bool
+}
+
+// NewPoissonLambdaIncrCrI returns an IncrementalCrI for Poisson rate λ, gamma prior with shape r and rate v.
+// Update(k, n) adds k events observed in n intervals.
+func NewPoissonLambdaIncrCrI(r, v, α float64) *IncrementalCrI {
+	if r < 0 || v < 0 {
+		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
+	}
+	checkα(α)
+	return &IncrementalCrI{
+		a: r, b: v, α: α, stale: true,
+		update: func(a, b *float64, k, n int64) {
+			if k < 0 || n <= 0 {
+				panic("bad data")
+			}
+			*a += float64(k)
+			*b += float64(n)
+		},
+		qtl: func(a, b, p float64) float64 { return GammaQtlFor(a, 1/b, p) },
+	}
+}
+
+// NewBinomPiIncrCrI returns an IncrementalCrI for the Binomial proportion, Beta(α0, β0) prior.
+// Update(k, n) adds k successes observed in n trials.
+func NewBinomPiIncrCrI(α0, β0, α float64) *IncrementalCrI {
+	if α0 < 0 || β0 < 0 {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+	}
+	checkα(α)
+	return &IncrementalCrI{
+		a: α0, b: β0, α: α, stale: true,
+		update: func(a, b *float64, k, n int64) {
+			if k < 0 || k > n {
+				panic(fmt.Sprintf("The number of observed successes (k) must be <= number of trials (n)"))
+			}
+			*a += float64(k)
+			*b += float64(n - k)
+		},
+		qtl: func(a, b, p float64) float64 { return BetaQtlFor(a, b, p) },
+	}
+}
+
+// Update adds new observations to the posterior. The interval is not recomputed until asked for.
+func (c *IncrementalCrI) Update(x, n int64) {
+	c.update(&c.a, &c.b, x, n)
+	c.stale = true
+}
+
+// Params returns the current posterior hyperparameters.
+func (c *IncrementalCrI) Params() (a, b float64) {
+	return c.a, c.b
+}
+
+// CrI returns the credible interval for the data seen so far.
+func (c *IncrementalCrI) CrI() (lo, hi float64) {
+	if c.stale {
+		αLo, αHi := TailsFromConfidence(1 - c.α)
+		c.lo = c.qtl(c.a, c.b, αLo)
+		c.hi = c.qtl(c.a, c.b, αHi)
+		c.stale = false
+	}
+	return c.lo, c.hi
+}
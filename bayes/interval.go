@@ -0,0 +1,133 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Highest density interval (HDI) of a posterior known only through its density, or through a sample.
+// HPDInterval in cri.go does the same for a posterior with a quantile function.
+// Ref.: Kruschke 2011: Chapter 23.3; Chen and Shao 1999, J. Comput. Graph. Stat. 8(1): 69-92.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+	"sort"
+)
+
+// hdiCells is the number of cells of the grid on which HDI tabulates the CDF.
+const hdiCells = 4096
+
+// HDI returns the shortest interval containing 1-α of the posterior with density pdf on [lo, hi].
+// pdf must be unimodal, and need not be normalized; it may be infinite at lo or hi. The interval is the set
+// where pdf exceeds a level h, with h found so that the set holds 1-α of the mass. The mass is integrated
+// by the midpoint rule on a grid of hdiCells cells, and the ends of the interval are found by FindRoot.
+// The mass of the interval is accurate to about 1e-6; less so if pdf rises faster than 1/sqrt(x-lo) at an end.
+func HDI(pdf func(float64) float64, lo, hi, α float64) (hdiLo, hdiHi float64) {
+	// Arguments:
+	// pdf		posterior density
+	// lo, hi	support of the posterior, or an interval outside which its mass is negligible
+	// α		posterior probability outside the interval
+	checkFinite("support", lo, hi)
+	if !(lo < hi) {
+		panic(fmt.Sprintf("lo must be less than hi"))
+	}
+	checkα(α)
+	credMass := 1 - α
+
+	// The grid is uniform in t, with x = lo + (hi-lo)*(3t²-2t³): the cells shrink towards both ends, and the
+	// factor dx/dt = 6t(1-t)*(hi-lo) tames singularities of pdf there.
+	xAt := func(t float64) float64 { return lo + (hi-lo)*t*t*(3-2*t) }
+	edges := make([]float64, hdiCells+1)
+	cdf := make([]float64, hdiCells+1)
+	top, fTop := 0, negInf
+	for i := 0; i < hdiCells; i++ {
+		t := (float64(i) + 0.5) / hdiCells
+		f := pdf(xAt(t))
+		if !(f >= 0) || isInf(f, 0) {
+			panic(fmt.Sprintf("pdf must be non-negative and finite inside (lo, hi)"))
+		}
+		if f > fTop {
+			top, fTop = i, f
+		}
+		edges[i] = xAt(float64(i) / hdiCells)
+		cdf[i+1] = cdf[i] + f*6*t*(1-t)
+	}
+	edges[hdiCells] = hi
+	total := cdf[hdiCells]
+	if total == 0 {
+		panic(fmt.Sprintf("pdf is zero on (lo, hi)"))
+	}
+	// F is the CDF, linear within each cell
+	F := func(x float64) float64 {
+		i := sort.SearchFloat64s(edges, x)
+		switch {
+		case i == 0:
+			return 0
+		case i > hdiCells:
+			return 1
+		}
+		i--
+		return (cdf[i] + (x-edges[i])/(edges[i+1]-edges[i])*(cdf[i+1]-cdf[i])) / total
+	}
+
+	mode := xAt((float64(top) + 0.5) / hdiCells)
+	first, last := xAt(0.5/hdiCells), xAt(1-0.5/hdiCells)
+	// ends returns the limits of the set where pdf >= h
+	ends := func(h float64) (a, b float64, err error) {
+		g := func(x float64) float64 { return pdf(x) - h }
+		a, b = lo, hi
+		if g(first) < 0 && first < mode {
+			if a, err = FindRoot(g, first, mode, 1e-12*(hi-lo)); err != nil {
+				return
+			}
+		}
+		if g(last) < 0 && last > mode {
+			b, err = FindRoot(g, mode, last, 1e-12*(hi-lo))
+		}
+		return
+	}
+	// mass above the level h, less credMass: decreasing in h; NaN, which stops FindRoot, if an end is not found
+	excess := func(h float64) float64 {
+		a, b, err := ends(h)
+		if err != nil {
+			return NaN
+		}
+		return F(b) - F(a) - credMass
+	}
+	h, err := FindRoot(excess, 0, fTop, 1e-12*fTop)
+	if err != nil {
+		panic(fmt.Sprintf("HDI: %v", err))
+	}
+	hdiLo, hdiHi, err = ends(h)
+	if err != nil {
+		panic(fmt.Sprintf("HDI: %v", err))
+	}
+	return
+}
+
+// HDIMC returns the shortest interval containing 1-α of a sample from the posterior, e.g. an MCMC chain:
+// of all the intervals between sorted sample values spanning round((1-α)*n) of them, the narrowest.
+// This is what HPDinterval of the R package coda computes.
+func HDIMC(samples []float64, α float64) (lo, hi float64) {
+	n := len(samples)
+	if n < 2 {
+		panic(fmt.Sprintf("at least 2 samples are needed"))
+	}
+	checkα(α)
+	checkFinite("samples", samples...)
+	x := append([]float64(nil), samples...)
+	sort.Float64s(x)
+	gap := int(math.Floor((1-α)*float64(n) + 0.5))
+	if gap < 1 {
+		gap = 1
+	}
+	if gap > n-1 {
+		gap = n - 1
+	}
+	best := 0
+	for i := 1; i+gap < n; i++ {
+		if x[i+gap]-x[i] < x[best+gap]-x[best] {
+			best = i
+		}
+	}
+	return x[best], x[best+gap]
+}
@@ -19,6 +19,10 @@ func LogPoissGamma(theta, y []float64, sh, rt float64) []float64 {
 	// Returns:
 	// logPosterior - vector of values of the log posterior for all values in theta
 
+	checkFinite("theta", theta...)
+	checkFinite("y", y...)
+	checkFinite("prior parameters", sh, rt)
+
 	lambda := make([]float64, len(theta))
 	for i, _ := range lambda {
 		lambda[i] = exp(theta[i])
@@ -0,0 +1,183 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Finite mixtures of Normal distributions.
+// Ref.: Gelman et al. 2004 (2e): Chapter 18.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+)
+
+// checkMixture panics unless means, stds and weights describe a valid mixture.
+func checkMixture(means, stds, weights []float64) {
+	k := len(means)
+	if k == 0 || len(stds) != k || len(weights) != k {
+		panic(fmt.Sprintf("means, stds and weights must have the same, non-zero length"))
+	}
+	checkFinite("means", means...)
+	checkFinite("stds", stds...)
+	checkFinite("weights", weights...)
+	w := 0.0
+	for j := range means {
+		if stds[j] <= 0 {
+			panic(fmt.Sprintf("standard deviations must be greater than zero"))
+		}
+		if weights[j] < 0 {
+			panic(fmt.Sprintf("weights must be non-negative"))
+		}
+		w += weights[j]
+	}
+	if w <= 0 {
+		panic(fmt.Sprintf("weights must not all be zero"))
+	}
+}
+
+// MixtureResponsibilities returns, for each data point, the posterior probabilities that it comes from each component
+// of the Normal mixture (the E-step of the EM algorithm). Weights need not sum to one.
+func MixtureResponsibilities(data []float64, means, stds, weights []float64) [][]float64 {
+	// Arguments:
+	// data		observations
+	// means, stds	means and standard deviations of the components
+	// weights	mixing weights of the components
+	// Returns:
+	// resp		resp[i][j] is the probability that data[i] comes from component j; each row sums to 1
+	checkMixture(means, stds, weights)
+	checkFinite("data", data...)
+	resp, _ := mixtureEStep(data, means, stds, weights)
+	return resp
+}
+
+// mixtureEStep returns the responsibilities and the log-likelihood of the Normal mixture.
+func mixtureEStep(data []float64, means, stds, weights []float64) (resp [][]float64, logLik float64) {
+	k := len(means)
+	lnpdf := make([]func(float64) float64, k)
+	w := 0.0
+	for j := range lnpdf {
+		lnpdf[j] = NormalLnPDF(means[j], stds[j])
+		w += weights[j]
+	}
+	resp = make([][]float64, len(data))
+	for i, x := range data {
+		r := make([]float64, k)
+		mx := negInf
+		for j := range r {
+			r[j] = log(weights[j]) + lnpdf[j](x)
+			if r[j] > mx {
+				mx = r[j]
+			}
+		}
+		// log-sum-exp, relative to the largest term
+		s := 0.0
+		for j := range r {
+			r[j] = exp(r[j] - mx)
+			s += r[j]
+		}
+		for j := range r {
+			r[j] /= s
+		}
+		resp[i] = r
+		logLik += mx + log(s) - log(w)
+	}
+	return resp, logLik
+}
+
+// Settings of the EM iterations of FitGaussianMixture.
+const (
+	mixtureMaxIter = 1000
+	mixtureTol     = 1e-8 // relative change of the log-likelihood
+)
+
+// FitGaussianMixture fits a mixture of k Normal distributions to data by maximum likelihood, using EM.
+// EM only finds a local maximum, so it is run from restarts random starting points, and the fit with
+// the highest log-likelihood is returned. The components are sorted by increasing mean.
+// If rng is nil, a freshly seeded source is used.
+func FitGaussianMixture(data []float64, k, restarts int, rng *rand.Rand) (means, stds, weights []float64, logLik float64) {
+	// Arguments:
+	// data		observations
+	// k		number of components
+	// restarts	number of EM runs from random starting points
+	// Returns:
+	// means, stds	means and standard deviations of the components
+	// weights	mixing weights of the components; they sum to 1
+	// logLik	log-likelihood of the fit
+	if k < 1 || len(data) < k {
+		panic(fmt.Sprintf("k must be at least 1, and not more than the number of observations"))
+	}
+	if restarts < 1 {
+		panic(fmt.Sprintf("restarts must be at least 1"))
+	}
+	checkFinite("data", data...)
+	rng = newSampler(rng).Rand()
+	_, sd := meanSd(data)
+	if !(sd > 0) {
+		panic(fmt.Sprintf("data must have at least two distinct values"))
+	}
+	logLik = negInf
+	for r := 0; r < restarts; r++ {
+		m, s, w, ll := mixtureEM(data, k, sd, rng)
+		if ll > logLik {
+			means, stds, weights, logLik = m, s, w, ll
+		}
+	}
+	return
+}
+
+// mixtureEM runs EM from k distinct observations, chosen at random, as the means, and the
+// overall standard deviation sd for every component.
+func mixtureEM(data []float64, k int, sd float64, rng *rand.Rand) (means, stds, weights []float64, logLik float64) {
+	n := float64(len(data))
+	floor := 1e-6 * sd // keeps a component from collapsing onto a single observation
+	means = make([]float64, k)
+	stds = make([]float64, k)
+	weights = make([]float64, k)
+	perm := rng.Perm(len(data))
+	for j := range means {
+		means[j] = data[perm[j]]
+		stds[j] = sd
+		weights[j] = 1 / float64(k)
+	}
+	prev := negInf
+	for it := 0; it < mixtureMaxIter; it++ {
+		resp, ll := mixtureEStep(data, means, stds, weights)
+		if ll-prev <= mixtureTol*abs(ll) {
+			break
+		}
+		prev = ll
+		for j := range means {
+			nj, sx := 0.0, 0.0
+			for i, x := range data {
+				nj += resp[i][j]
+				sx += resp[i][j] * x
+			}
+			if nj == 0 { // empty component: keep its mean and std, with zero weight
+				weights[j] = 0
+				continue
+			}
+			means[j] = sx / nj
+			ss := 0.0
+			for i, x := range data {
+				d := x - means[j]
+				ss += resp[i][j] * d * d
+			}
+			stds[j] = sqrt(ss / nj)
+			if stds[j] < floor {
+				stds[j] = floor
+			}
+			weights[j] = nj / n
+		}
+	}
+	_, logLik = mixtureEStep(data, means, stds, weights)
+
+	// sort the components by increasing mean
+	for i := 1; i < k; i++ {
+		for j := i; j > 0 && means[j] < means[j-1]; j-- {
+			means[j], means[j-1] = means[j-1], means[j]
+			stds[j], stds[j-1] = stds[j-1], stds[j]
+			weights[j], weights[j-1] = weights[j-1], weights[j]
+		}
+	}
+	return
+}
@@ -0,0 +1,134 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Online EM for finite mixtures of Normal distributions.
+// Each observation is folded into running averages of the sufficient statistics with step size rate(t),
+// and the parameters are recomputed from them, so memory does not grow with the length of the stream.
+// Ref.: Cappé and Moulines 2009, J. R. Statist. Soc. B 71(3): 593-613.
+
+import (
+	"fmt"
+)
+
+// onlineGMMMinWeight is the least running weight of a component of OnlineGMM. A component that starts at,
+// or decays to, less keeps that much weight at its current mean and standard deviation, so it still takes
+// responsibility for observations near its mean and is not reshaped by a few stray ones.
+const onlineGMMMinWeight = 1e-3
+
+// OnlineGMM fits a Normal mixture to a stream of observations by stochastic EM.
+type OnlineGMM struct {
+	means, stds, weights []float64
+	s0, s1, s2           []float64 // running averages of resp, resp*x and resp*x²
+	rate                 func(t int) float64
+	n                    int
+}
+
+// OnlineGMMRate returns the step size schedule (t + t0)^(-κ). Convergence needs κ in (0.5, 1];
+// a larger t0 keeps the first observations from overriding the starting values.
+func OnlineGMMRate(κ, t0 float64) func(t int) float64 {
+	if !(κ > 0.5 && κ <= 1) {
+		panic(fmt.Sprintf("κ must be in (0.5, 1]"))
+	}
+	if !(t0 >= 0) {
+		panic(fmt.Sprintf("t0 must be non-negative"))
+	}
+	return func(t int) float64 {
+		return pow(float64(t)+t0, -κ)
+	}
+}
+
+// NewOnlineGMM returns an OnlineGMM started at the given mixture. If rate is nil, OnlineGMMRate(0.6, 10) is used.
+// A component of zero weight starts with weight about onlineGMMMinWeight at its given mean and standard deviation,
+// and gains weight from the observations near its mean.
+func NewOnlineGMM(means, stds, weights []float64, rate func(t int) float64) *OnlineGMM {
+	checkMixture(means, stds, weights)
+	if rate == nil {
+		rate = OnlineGMMRate(0.6, 10)
+	}
+	k := len(means)
+	g := &OnlineGMM{
+		means:   make([]float64, k),
+		stds:    make([]float64, k),
+		weights: make([]float64, k),
+		s0:      make([]float64, k),
+		s1:      make([]float64, k),
+		s2:      make([]float64, k),
+		rate:    rate,
+	}
+	// mStep seeds components of zero weight from their means and standard deviations
+	copy(g.means, means)
+	copy(g.stds, stds)
+	w := 0.0
+	for j := range weights {
+		w += weights[j]
+	}
+	for j := range means {
+		g.s0[j] = weights[j] / w
+		g.s1[j] = g.s0[j] * means[j]
+		g.s2[j] = g.s0[j] * (stds[j]*stds[j] + means[j]*means[j])
+	}
+	g.mStep()
+	return g
+}
+
+// Add folds the observation x into the fit.
+func (g *OnlineGMM) Add(x float64) {
+	checkFinite("x", x)
+	resp := MixtureResponsibilities([]float64{x}, g.means, g.stds, g.weights)[0]
+	g.n++
+	γ := g.rate(g.n)
+	if !(γ > 0 && γ <= 1) {
+		panic(fmt.Sprintf("rate must return a step size in (0, 1]"))
+	}
+	for j, r := range resp {
+		g.s0[j] += γ * (r - g.s0[j])
+		g.s1[j] += γ * (r*x - g.s1[j])
+		g.s2[j] += γ * (r*x*x - g.s2[j])
+	}
+	g.mStep()
+}
+
+// mStep recomputes the parameters from the sufficient statistics, after seeding those of a component
+// with less than onlineGMMMinWeight at its current mean and standard deviation.
+func (g *OnlineGMM) mStep() {
+	w := 0.0
+	for j := range g.s0 {
+		if g.s0[j] < onlineGMMMinWeight {
+			g.s0[j] = onlineGMMMinWeight
+			g.s1[j] = g.s0[j] * g.means[j]
+			g.s2[j] = g.s0[j] * (g.stds[j]*g.stds[j] + g.means[j]*g.means[j])
+		}
+		w += g.s0[j]
+	}
+	for j := range g.s0 {
+		g.weights[j] = g.s0[j] / w
+		μ := g.s1[j] / g.s0[j]
+		v := g.s2[j]/g.s0[j] - μ*μ
+		if v <= 0 {
+			continue
+		}
+		g.means[j] = μ
+		g.stds[j] = sqrt(v)
+	}
+}
+
+// N returns the number of observations added so far.
+func (g *OnlineGMM) N() int {
+	return g.n
+}
+
+// Means returns the current means of the components.
+func (g *OnlineGMM) Means() []float64 {
+	return append([]float64(nil), g.means...)
+}
+
+// Stds returns the current standard deviations of the components.
+func (g *OnlineGMM) Stds() []float64 {
+	return append([]float64(nil), g.stds...)
+}
+
+// Weights returns the current mixing weights of the components; they sum to 1.
+func (g *OnlineGMM) Weights() []float64 {
+	return append([]float64(nil), g.weights...)
+}
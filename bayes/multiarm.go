@@ -0,0 +1,113 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Comparison of several groups (arms) by simulation from their posteriors.
+// Each posterior is given as a sampler, a function returning one draw from the posterior using rng.
+// Ref.: Scott, S.L. 2010: A modern Bayesian look at the multi-armed bandit. Appl. Stochastic Models Bus. Ind. 26: 639-658.
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// ProbabilityBest returns, for each group, the posterior probability that its parameter is the largest.
+// Ties are shared equally among the tied groups. If rng is nil, a freshly seeded source is used.
+func ProbabilityBest(posteriors []func(rng *rand.Rand) float64, draws int, rng *rand.Rand) []float64 {
+	// Arguments:
+	// posteriors	samplers of the posterior of each group
+	// draws	number of simulated draws from each posterior
+	// rng		source of randomness
+	if len(posteriors) == 0 {
+		panic(fmt.Sprintf("at least one posterior needed"))
+	}
+	if draws <= 0 {
+		panic(fmt.Sprintf("draws must be greater than zero"))
+	}
+	rng = newSampler(rng).Rand()
+	k := len(posteriors)
+	wins := make([]float64, k)
+	θ := make([]float64, k)
+	best := make([]int, 0, k)
+	for i := 0; i < draws; i++ {
+		for j, post := range posteriors {
+			θ[j] = post(rng)
+		}
+		best = argMaxAll(θ, best[:0])
+		for _, j := range best {
+			wins[j] += 1 / float64(len(best))
+		}
+	}
+	for j := range wins {
+		wins[j] /= float64(draws)
+	}
+	return wins
+}
+
+// argMaxAll appends to idx the indices of all maximal elements of x.
+func argMaxAll(x []float64, idx []int) []int {
+	mx := negInf
+	for j, v := range x {
+		switch {
+		case v > mx:
+			mx = v
+			idx = append(idx[:0], j)
+		case v == mx:
+			idx = append(idx, j)
+		}
+	}
+	return idx
+}
+
+// ThompsonAllocation returns the index of the group to sample next, by Thompson sampling:
+// one draw is taken from each posterior and the group with the largest draw is chosen,
+// so each group is chosen with its probability of being best. Ties go to the lowest index.
+// If rng is nil, a freshly seeded source is used.
+func ThompsonAllocation(posteriors []func(rng *rand.Rand) float64, rng *rand.Rand) int {
+	if len(posteriors) == 0 {
+		panic(fmt.Sprintf("at least one posterior needed"))
+	}
+	rng = newSampler(rng).Rand()
+	arm := 0
+	mx := negInf
+	for j, post := range posteriors {
+		if θ := post(rng); θ > mx {
+			arm, mx = j, θ
+		}
+	}
+	return arm
+}
+
+// ExpectedLoss returns the posterior expected amount by which the parameter of the chosen group falls short of the largest one,
+// E[max θ - θ(chosen)]. Sampling can stop once the expected loss of the leading group is below a threshold of indifference.
+// If rng is nil, a freshly seeded source is used.
+func ExpectedLoss(posteriors []func(rng *rand.Rand) float64, chosen int, draws int, rng *rand.Rand) float64 {
+	// Arguments:
+	// posteriors	samplers of the posterior of each group
+	// chosen	index of the chosen group
+	// draws	number of simulated draws from each posterior
+	// rng		source of randomness
+	if chosen < 0 || chosen >= len(posteriors) {
+		panic(fmt.Sprintf("chosen must be the index of one of the posteriors"))
+	}
+	if draws <= 0 {
+		panic(fmt.Sprintf("draws must be greater than zero"))
+	}
+	rng = newSampler(rng).Rand()
+	loss := 0.0
+	for i := 0; i < draws; i++ {
+		mx := negInf
+		θc := 0.0
+		for j, post := range posteriors {
+			θ := post(rng)
+			if θ > mx {
+				mx = θ
+			}
+			if j == chosen {
+				θc = θ
+			}
+		}
+		loss += mx - θc
+	}
+	return loss / float64(draws)
+}
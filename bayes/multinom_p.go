@@ -15,29 +15,94 @@ import (
 // for Haldane improper prior, use α[i] = 0
 // Ericson 1969 recommends prior with sum(α[i]) small, of the order of 1, e.g., 1/len(α)
 // Aitkin 2010: 96-107
+// α is not modified.
 func MultinomPiPDFDirPri(α, x []float64) float64 {
 	// if α == nil, use Haldane
 	if α == nil {
-		for i := 0; i < len(x); i++ {
-			α[i] = 0
-		}
+		α = make([]float64, len(x))
 	}
 
 	if len(α) != len(x) {
 		panic(fmt.Sprintf("len(α) != len(x)"))
 	}
 
+	post := make([]float64, len(α))
 	for i := 0; i < len(x); i++ {
-		α[i] += x[i] // posterior params
+		post[i] = α[i] + x[i] // posterior params
 	}
-	return DirichletPDFAt(α, x)
+	return DirichletPDFAt(post, x)
 }
 
 // Sampling from posterior, Dirichlet prior
-// Returns an array of sampled Multinomial Pi's
+// Returns an array of sampled Multinomial Pi's; α is not modified.
 func MultinomPiNext(α, x []float64) []float64 {
+	if len(α) != len(x) {
+		panic(fmt.Sprintf("len(α) != len(x)"))
+	}
+	post := make([]float64, len(α))
 	for i := 0; i < len(x); i++ {
-		α[i] += x[i] // posterior params
+		post[i] = α[i] + x[i] // posterior params
 	}
-	return DirichletNext(α)
+	return DirichletNext(post)
+}
+
+// MultinomPiPostParams returns the concentration parameters conc[i] + counts[i] of the Dirichlet posterior of p,
+// after counts[i] observations in category i, and Dirichlet(conc) prior. conc is not modified.
+// For the uniform prior use conc[i] = 1, for Jeffreys' prior conc[i] = 0.5, for the improper Haldane prior conc[i] = 0;
+// the posterior is improper if some conc[i] + counts[i] is zero.
+func MultinomPiPostParams(counts []int64, conc []float64) []float64 {
+	// counts	observed counts of each category
+	// conc		concentration parameters of the Dirichlet prior
+	if len(counts) != len(conc) {
+		panic(fmt.Sprintf("len(counts) != len(conc)"))
+	}
+	if len(counts) < 2 {
+		panic(fmt.Sprintf("at least two categories are needed"))
+	}
+	post := make([]float64, len(conc))
+	for i, k := range counts {
+		if k < 0 {
+			panic("bad data")
+		}
+		if conc[i] < 0 || isInf(conc[i], 1) {
+			panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+		}
+		post[i] = conc[i] + float64(k)
+		if post[i] <= 0 {
+			panic(fmt.Sprintf("posterior is improper: conc[%d] must be greater than zero when no events were observed", i))
+		}
+	}
+	return post
+}
+
+// MultinomPiPostMean returns the posterior means (conc[i] + counts[i]) / Σ(conc[j] + counts[j]) of p, Dirichlet prior.
+func MultinomPiPostMean(counts []int64, conc []float64) []float64 {
+	return DirichletMean(MultinomPiPostParams(counts, conc))
+}
+
+// MultinomPiMarginalDirPri returns the marginal posterior of p[i], Dirichlet prior: the Beta distribution with
+// parameters conc[i] + counts[i] and the sum of the other posterior parameters, as for category i against all the others.
+func MultinomPiMarginalDirPri(counts []int64, conc []float64, i int) BetaDist {
+	post := MultinomPiPostParams(counts, conc)
+	if i < 0 || i >= len(post) {
+		panic(fmt.Sprintf("category %d out of range", i))
+	}
+	rest := fZero
+	for j, a := range post {
+		if j != i {
+			rest += a
+		}
+	}
+	return BetaDist{A: post[i], B: rest}
+}
+
+// MultinomPiCrIDirPri returns the equal tail area credible interval of p[i], Dirichlet prior, from its Beta marginal.
+func MultinomPiCrIDirPri(counts []int64, conc []float64, i int, α float64) (lo, hi float64) {
+	// counts	observed counts of each category
+	// conc		concentration parameters of the Dirichlet prior
+	// i		category
+	// α		posterior probability that the true proportion lies outside the credible interval
+	m := MultinomPiMarginalDirPri(counts, conc, i)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	return m.Qtl(αLo), m.Qtl(αHi)
 }
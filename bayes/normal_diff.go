@@ -4,55 +4,38 @@
 package bayes
 
 import (
+	"fmt"
 	. "github.com/datastream/probab/dst"
 	"math"
 )
 
 // KNOWN variances, and NORMAL priors
 
+// normalMuDiffNPriKn returns the posterior of μ1-μ2, KNOWN variances, and NORMAL priors.
+func normalMuDiffNPriKn(nObs1, nObs2 int, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) NormalPrior {
+	// for independent samples, use independent priors for both means
+	// posteriors are Normal with params from eqs. 11.5 and 11.6
+	post1 := NormalPrior{μ1Pri, σ1Pri}.Update(nObs1, ȳ1, σ1)
+	post2 := NormalPrior{μ2Pri, σ2Pri}.Update(nObs2, ȳ2, σ2)
+	return post1.Diff(post2)
+}
+
 // Posterior PDF of the difference of two means (μ1-μ2) of Normal distributions with KNOWN variances, and NORMAL priors
 // Bolstad 2007:245-246
 func NormalMuDiffPDFNPriKn(nObs1, nObs2 int, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(x float64) float64 {
-	// for independent samples, use independent priors for both means
-	// posteriors are Normal with params from eqs. 11.5 and 11.6
-	μ1Post := NormMuPostMean(nObs1, ȳ1, σ1, μ1Pri, σ1Pri)
-	σ1Post := NormMuPostStd(nObs1, σ1, μ1Pri, σ1Pri)
-	μ2Post := NormMuPostMean(nObs2, ȳ2, σ2, μ2Pri, σ2Pri)
-	σ2Post := NormMuPostStd(nObs2, σ2, μ2Pri, σ2Pri)
-	//difference posterior is Normal with params:
-	μdPost := μ1Post - μ2Post
-	σdPost := math.Sqrt(σ1Post*σ1Post + σ2Post*σ2Post)
-	return NormalPDF(μdPost, σdPost)
+	return normalMuDiffNPriKn(nObs1, nObs2, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri).PDF()
 }
 
 // Posterior CDF of the difference of two means (μ1-μ2) of Normal distributions with KNOWN variances, and NORMAL priors
 // Bolstad 2007:245-246
 func NormalMuDiffCDFNPriKn(nObs1, nObs2 int, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(x float64) float64 {
-	// for independent samples, use independent priors for both means
-	// posteriors are Normal with params from eqs. 11.5 and 11.6
-	μ1Post := NormMuPostMean(nObs1, ȳ1, σ1, μ1Pri, σ1Pri)
-	σ1Post := NormMuPostStd(nObs1, σ1, μ1Pri, σ1Pri)
-	μ2Post := NormMuPostMean(nObs2, ȳ2, σ2, μ2Pri, σ2Pri)
-	σ2Post := NormMuPostStd(nObs2, σ2, μ2Pri, σ2Pri)
-	//difference posterior is Normal with params:
-	μdPost := μ1Post - μ2Post
-	σdPost := math.Sqrt(σ1Post*σ1Post + σ2Post*σ2Post)
-	return NormalCDF(μdPost, σdPost)
+	return normalMuDiffNPriKn(nObs1, nObs2, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri).CDF()
 }
 
 // Posterior quantile of the difference of two means (μ1-μ2) of Normal distributions with KNOWN variances, and NORMAL priors
 // Bolstad 2007:245-246
 func NormalMuDiffQtlNPriKn(nObs1, nObs2 int, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(p float64) float64 {
-	// for independent samples, use independent priors for both means
-	// posteriors are Normal with params from eqs. 11.5 and 11.6
-	μ1Post := NormMuPostMean(nObs1, ȳ1, σ1, μ1Pri, σ1Pri)
-	σ1Post := NormMuPostStd(nObs1, σ1, μ1Pri, σ1Pri)
-	μ2Post := NormMuPostMean(nObs2, ȳ2, σ2, μ2Pri, σ2Pri)
-	σ2Post := NormMuPostStd(nObs2, σ2, μ2Pri, σ2Pri)
-	//difference posterior is Normal with params:
-	μdPost := μ1Post - μ2Post
-	σdPost := math.Sqrt(σ1Post*σ1Post + σ2Post*σ2Post)
-	return NormalQtl(μdPost, σdPost)
+	return normalMuDiffNPriKn(nObs1, nObs2, ȳ1, ȳ2, σ1, σ2, μ1Pri, σ1Pri, μ2Pri, σ2Pri).Qtl()
 }
 
 // UNKNOWN variances (Behrens-Fisher problem), and NORMAL priors
@@ -97,6 +80,23 @@ func satterthwaitenu(estvar1 float64, nObs1 int, estvar2 float64, nObs2 int) flo
 	return nu
 }
 
+// behrensFisherNPri returns the posterior mean and standard deviation of μ1-μ2, and Satterthwaite's df, NORMAL priors.
+func behrensFisherNPri(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) (μdPost, σdPost, nu float64) {
+	d := normalMuDiffNPriKn(nObs1, nObs2, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri)
+	μdPost, σdPost = d.Mean(), d.Std()
+	nu = satterthwaitenu(s1*s1, nObs1, s2*s2, nObs2)
+	return
+}
+
+// behrensFisherFPri returns the posterior mean and standard deviation of μ1-μ2, and Satterthwaite's df, FLAT priors.
+func behrensFisherFPri(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2 float64) (μdPost, σdPost, nu float64) {
+	//difference posterior is Normal with params:
+	μdPost = ȳ1 - ȳ2
+	σdPost = math.Sqrt(s1*s1/float64(nObs1) + s2*s2/float64(nObs2))
+	nu = satterthwaitenu(s1*s1, nObs1, s2*s2, nObs2)
+	return
+}
+
 // Quantile of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances (Behrens-Fisher problem), and NORMAL priors 
 // Bolstad 2007:245-246
 // untested ...
@@ -104,22 +104,9 @@ func NormalMuDiffQtlNPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, 
 	// for independent samples, use independent priors for both means
 	// s1 and s2 are estimated standard deviations math.Sqrt(varest())
 	return func(p float64) float64 {
-		var q float64
-		μ1Post := NormMuPostMean(nObs1, ȳ1, s1, μ1Pri, σ1Pri)
-		σ1Post := NormMuPostStd(nObs1, s1, μ1Pri, σ1Pri)
-		μ2Post := NormMuPostMean(nObs2, ȳ2, s2, μ2Pri, σ2Pri)
-		σ2Post := NormMuPostStd(nObs2, s2, μ2Pri, σ2Pri)
-		//difference posterior is Normal with params:
-		μdPost := μ1Post - μ2Post
-		nu := satterthwaitenu(s1*s1, nObs1, s2*s2, nObs2)
+		μdPost, σdPost, nu := behrensFisherNPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri)
 		t := StudentsTQtl(nu)
-		α := 1 - 2*p
-		if p < 0.5 {
-			q = μdPost - t(α/2)*math.Sqrt(σ1Post*σ1Post+σ2Post*σ2Post)
-		} else {
-			q = μdPost + t(α/2)*math.Sqrt(σ1Post*σ1Post+σ2Post*σ2Post)
-		}
-		return q
+		return μdPost + t(p)*σdPost
 	}
 }
 
@@ -130,39 +117,221 @@ func NormalMuDiffCrINPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, 
 	// for independent samples, use independent priors for both means
 	// s1 and s2 are estimated standard deviations math.Sqrt(varest())
 	return func(α float64) (lo, hi float64) {
-		μ1Post := NormMuPostMean(nObs1, ȳ1, s1, μ1Pri, σ1Pri)
-		σ1Post := NormMuPostStd(nObs1, s1, μ1Pri, σ1Pri)
-		μ2Post := NormMuPostMean(nObs2, ȳ2, s2, μ2Pri, σ2Pri)
-		σ2Post := NormMuPostStd(nObs2, s2, μ2Pri, σ2Pri)
-		//difference posterior is Normal with params:
-		μdPost := μ1Post - μ2Post
-		nu := satterthwaitenu(s1*s1, nObs1, s2*s2, nObs2)
+		μdPost, σdPost, nu := behrensFisherNPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri)
 		t := StudentsTQtl(nu)
-		lo = μdPost - t(α/2)*math.Sqrt(σ1Post*σ1Post+σ2Post*σ2Post)
-		hi = μdPost + t(α/2)*math.Sqrt(σ1Post*σ1Post+σ2Post*σ2Post)
+		αLo, αHi := TailsFromConfidence(1 - α)
+		lo = μdPost + t(αLo)*σdPost
+		hi = μdPost + t(αHi)*σdPost
 		return
 	}
 }
 
+// One-sided lower credible bound of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances
+// (Behrens-Fisher problem), and NORMAL priors: P(μ1-μ2 > lo) = 1-α.
+// The bound at α/2 is the lower end of the two-sided interval at α.
+func NormalMuDiffLowerNPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(α float64) (lo float64) {
+	return func(α float64) (lo float64) {
+		checkα(α)
+		μdPost, σdPost, nu := behrensFisherNPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri)
+		return μdPost + StudentsTQtlFor(nu, α)*σdPost
+	}
+}
+
+// One-sided upper credible bound of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances
+// (Behrens-Fisher problem), and NORMAL priors: P(μ1-μ2 < hi) = 1-α.
+func NormalMuDiffUpperNPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(α float64) (hi float64) {
+	return func(α float64) (hi float64) {
+		checkα(α)
+		μdPost, σdPost, nu := behrensFisherNPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri)
+		return μdPost + StudentsTQtlFor(nu, 1-α)*σdPost
+	}
+}
+
 // Credible interval of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances (Behrens-Fisher problem), and FLAT priors
+// The posterior of μ1-μ2 is located at ȳ1-ȳ2; μ1Pri, σ1Pri, μ2Pri, σ2Pri are ignored.
 // Bolstad 2007:245-246
 // untested ...
 func NormalMuDiffCrIFPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri, α float64) func(α float64) (lo, hi float64) {
 	// for independent samples, use independent priors for both means
 	// s1 and s2 are estimated standard deviations math.Sqrt(varest())
 	return func(α float64) (lo, hi float64) {
-		μ1Post := NormMuPostMean(nObs1, ȳ1, s1, μ1Pri, σ1Pri)
-		μ2Post := NormMuPostMean(nObs2, ȳ2, s2, μ2Pri, σ2Pri)
-		//difference posterior is Normal with params:
-		μdPost := μ1Post - μ2Post
-		nu := satterthwaitenu(s1*s1, nObs1, s2*s2, nObs2)
+		μdPost, σdPost, nu := behrensFisherFPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2)
 		t := StudentsTQtl(nu)
-		lo = μdPost - t(α/2)*math.Sqrt(s1*s1+s2*s2)
-		hi = μdPost + t(α/2)*math.Sqrt(s1*s1+s2*s2)
+		αLo, αHi := TailsFromConfidence(1 - α)
+		lo = μdPost + t(αLo)*σdPost
+		hi = μdPost + t(αHi)*σdPost
 		return
 	}
 }
 
+// One-sided lower credible bound of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances
+// (Behrens-Fisher problem), and FLAT priors: P(μ1-μ2 > lo) = 1-α.
+// μ1Pri, σ1Pri, μ2Pri, σ2Pri are ignored, as in NormalMuDiffCrIFPriUn.
+func NormalMuDiffLowerFPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(α float64) (lo float64) {
+	return func(α float64) (lo float64) {
+		checkα(α)
+		μdPost, σdPost, nu := behrensFisherFPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2)
+		return μdPost + StudentsTQtlFor(nu, α)*σdPost
+	}
+}
+
+// One-sided upper credible bound of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances
+// (Behrens-Fisher problem), and FLAT priors: P(μ1-μ2 < hi) = 1-α.
+// μ1Pri, σ1Pri, μ2Pri, σ2Pri are ignored, as in NormalMuDiffCrIFPriUn.
+func NormalMuDiffUpperFPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, μ1Pri, σ1Pri, μ2Pri, σ2Pri float64) func(α float64) (hi float64) {
+	return func(α float64) (hi float64) {
+		checkα(α)
+		μdPost, σdPost, nu := behrensFisherFPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2)
+		return μdPost + StudentsTQtlFor(nu, 1-α)*σdPost
+	}
+}
+
+// JEFFREYS priors
+
+// Welch-Satterthwaite degrees of freedom, unrounded
+// Welch, B.L. 1947: The generalization of "Student's" problem when several different population variances are involved. Biometrika 34: 28-35.
+func welchnu(estvar1 float64, nObs1 int, estvar2 float64, nObs2 int) float64 {
+	v1 := estvar1 / float64(nObs1)
+	v2 := estvar2 / float64(nObs2)
+	return (v1 + v2) * (v1 + v2) / (v1*v1/float64(nObs1-1) + v2*v2/float64(nObs2-1))
+}
+
+// Credible interval of the difference of two means (μ1-μ2) of Normal distributions with KNOWN variances, and JEFFREYS priors
+// For known σ, Jeffreys' prior of each mean is flat, and the posterior of μ1-μ2 is Normal(ȳ1-ȳ2, sqrt(σ1²/n1+σ2²/n2)).
+// Bolstad 2007 (2e): 245
+func NormalMuDiffCrIJPriKn(nObs1, nObs2 int, ȳ1, ȳ2, σ1, σ2, α float64) (lo, hi float64) {
+	// nObs1, nObs2	sample sizes
+	// ȳ1, ȳ2	sample means
+	// σ1, σ2	standard deviations of populations, assumed to be known
+	// α		posterior probability that μ1-μ2 lies outside the credible interval
+	checkNormσ(σ1)
+	checkNormσ(σ2)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	μdPost := ȳ1 - ȳ2
+	σdPost := math.Sqrt(σ1*σ1/float64(nObs1) + σ2*σ2/float64(nObs2))
+	lo = NormalQtlFor(μdPost, σdPost, αLo)
+	hi = NormalQtlFor(μdPost, σdPost, αHi)
+	return
+}
+
+// Credible interval of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN variances (Behrens-Fisher problem), and JEFFREYS priors
+// Independent Jeffreys' priors g(μi, σi) ∝ 1/σi make each μi a scaled Student's t with nObsi-1 df; their difference
+// (the Behrens-Fisher distribution) is approximated by Student's t with Welch-Satterthwaite df.
+// Bolstad 2007 (2e): 246-248.
+func NormalMuDiffCrIJPriUn(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, α float64) (lo, hi float64) {
+	// nObs1, nObs2	sample sizes
+	// ȳ1, ȳ2	sample means
+	// s1, s2	sample standard deviations math.Sqrt(varest())
+	// α		posterior probability that μ1-μ2 lies outside the credible interval
+	αLo, αHi := TailsFromConfidence(1 - α)
+	μdPost, σdPost, nu := normalMuDiffJPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, false)
+	t := StudentsTQtl(nu)
+	lo = μdPost + t(αLo)*σdPost
+	hi = μdPost + t(αHi)*σdPost
+	return
+}
+
+// normalMuDiffJPri returns the location, scale and df of the Student's t posterior of μ1-μ2, UNKNOWN variances, and JEFFREYS priors.
+// With equalVar, the variances are taken as equal, and the prior g(μ1, μ2, σ) ∝ 1/σ gives the pooled t with nObs1+nObs2-2 df;
+// otherwise it is the Behrens-Fisher distribution of NormalMuDiffCrIJPriUn, approximated with Welch-Satterthwaite df.
+// Bolstad 2007 (2e): 244-248.
+func normalMuDiffJPri(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2 float64, equalVar bool) (μdPost, σdPost, nu float64) {
+	if nObs1 < 2 || nObs2 < 2 {
+		panic(fmt.Sprintf("sample sizes must be at least 2"))
+	}
+	if !(s1 > 0 && s2 > 0) {
+		panic(fmt.Sprintf("sample standard deviations must be greater than zero"))
+	}
+	n1, n2 := float64(nObs1), float64(nObs2)
+	μdPost = ȳ1 - ȳ2
+	if equalVar {
+		nu = n1 + n2 - 2
+		σdPost = pooledSd(nObs1, nObs2, s1, s2) * math.Sqrt(1/n1+1/n2)
+		return
+	}
+	σdPost = math.Sqrt(s1*s1/n1 + s2*s2/n2)
+	nu = welchnu(s1*s1, nObs1, s2*s2, nObs2)
+	return
+}
+
+// pooledSd returns the pooled standard deviation of two samples.
+func pooledSd(nObs1, nObs2 int, s1, s2 float64) float64 {
+	n1, n2 := float64(nObs1), float64(nObs2)
+	return math.Sqrt(((n1-1)*s1*s1 + (n2-1)*s2*s2) / (n1 + n2 - 2))
+}
+
+// Credible interval of the difference of two means (μ1-μ2) of Normal distributions with UNKNOWN but EQUAL variances, and JEFFREYS prior
+// The posterior of μ1-μ2 is Student's t with nObs1+nObs2-2 df, location ȳ1-ȳ2 and scale sp·sqrt(1/nObs1+1/nObs2),
+// sp the pooled standard deviation.
+// Bolstad 2007 (2e): 244-245.
+func NormalMuDiffCrIJPriEqVar(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, α float64) (lo, hi float64) {
+	// nObs1, nObs2	sample sizes
+	// ȳ1, ȳ2	sample means
+	// s1, s2	sample standard deviations math.Sqrt(varest())
+	// α		posterior probability that μ1-μ2 lies outside the credible interval
+	αLo, αHi := TailsFromConfidence(1 - α)
+	μdPost, σdPost, nu := normalMuDiffJPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, true)
+	t := StudentsTQtl(nu)
+	return μdPost + t(αLo)*σdPost, μdPost + t(αHi)*σdPost
+}
+
+// Posterior probability P(μ1 > μ2) of the difference of two means of Normal distributions with UNKNOWN variances, and JEFFREYS priors
+// equalVar selects the pooled route of NormalMuDiffCrIJPriEqVar, otherwise the Behrens-Fisher route of NormalMuDiffCrIJPriUn.
+func NormalMuDiffProbGreaterJPri(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2 float64, equalVar bool) float64 {
+	μdPost, σdPost, nu := normalMuDiffJPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, equalVar)
+	return StudentsTCDFAt(nu, μdPost/σdPost)
+}
+
+// Posterior probability that the difference of two means (μ1-μ2) lies in the region of practical equivalence [-δ, δ] (ROPE),
+// UNKNOWN variances, and JEFFREYS priors.
+// Kruschke 2013: Bayesian estimation supersedes the t test. J Exp Psychol Gen 142: 573-603.
+func NormalMuDiffROPEProbJPri(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, δ float64, equalVar bool) float64 {
+	if !(δ > 0) {
+		panic(fmt.Sprintf("δ must be greater than zero"))
+	}
+	μdPost, σdPost, nu := normalMuDiffJPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, equalVar)
+	cdf := StudentsTCDF(nu)
+	return cdf((δ-μdPost)/σdPost) - cdf((-δ-μdPost)/σdPost)
+}
+
+// Equivalence test of two means, UNKNOWN variances, and JEFFREYS priors: two one-sided tests (TOST) at level α.
+// The means are equivalent if both P(μ1-μ2 <= lowerBound) < α and P(μ1-μ2 >= upperBound) < α; pValue is the larger
+// of the two. Without equalVar these are the p-values of the one-sided Welch t-tests. For the bounds [-δ, δ] the means
+// are equivalent exactly when the equal tail (1-2α) credible interval lies inside.
+// Schuirmann 1987: A comparison of the two one-sided tests procedure. J Pharmacokinet Biopharm 15: 657-680.
+func NormalMuDiffEquivTstJPri(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, lowerBound, upperBound, α float64, equalVar bool) (equivalent bool, pValue float64) {
+	if !(lowerBound < upperBound) {
+		panic(fmt.Sprintf("lowerBound must be less than upperBound"))
+	}
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	μdPost, σdPost, nu := normalMuDiffJPri(nObs1, nObs2, ȳ1, ȳ2, s1, s2, equalVar)
+	cdf := StudentsTCDF(nu)
+	pLo := cdf((lowerBound - μdPost) / σdPost)
+	pHi := cdf((μdPost - upperBound) / σdPost)
+	pValue = math.Max(pLo, pHi)
+	return pValue < α, pValue
+}
+
+// NormalCohenD returns Cohen's d, the difference of two sample means in units of the pooled standard deviation,
+// and its (1-α) interval from the large sample Normal approximation of its standard error,
+// sqrt((nObs1+nObs2)/(nObs1·nObs2) + d²/(2(nObs1+nObs2))).
+// Hedges and Olkin 1985: Statistical Methods for Meta-Analysis: 86.
+func NormalCohenD(nObs1, nObs2 int, ȳ1, ȳ2, s1, s2, α float64) (d, lo, hi float64) {
+	if nObs1 < 2 || nObs2 < 2 {
+		panic(fmt.Sprintf("sample sizes must be at least 2"))
+	}
+	if !(s1 > 0 && s2 > 0) {
+		panic(fmt.Sprintf("sample standard deviations must be greater than zero"))
+	}
+	αLo, αHi := TailsFromConfidence(1 - α)
+	n1, n2 := float64(nObs1), float64(nObs2)
+	d = (ȳ1 - ȳ2) / pooledSd(nObs1, nObs2, s1, s2)
+	se := math.Sqrt((n1+n2)/(n1*n2) + d*d/(2*(n1+n2)))
+	return d, d + ZQtlFor(αLo)*se, d + ZQtlFor(αHi)*se
+}
+
 // Posterior moments
 // Mean = modus = median; standard deviation; skewness = 0; kurtosis = 0;
 
@@ -0,0 +1,58 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Everything about the difference μ1-μ2 of two Normal means in one call, from the summary statistics of two
+// independent samples with unknown variances and Jeffreys priors: the posterior, its credible interval,
+// P(μ1 > μ2), Cohen's d, and the equivalence decision for a region of practical equivalence [-δ, δ].
+// Bolstad 2007 (2e): Chapter 13.
+
+import (
+	"fmt"
+)
+
+// NormalMuDiffReport holds the results of NormalTwoSampleReport.
+type NormalMuDiffReport struct {
+	EqualVar           bool    // pooled variance, otherwise Behrens-Fisher
+	Mean, Scale, Df    float64 // the posterior of μ1-μ2 is Student's t with location Mean, scale Scale, and Df degrees of freedom
+	CrILo, CrIHi       float64 // equal tail credible interval of μ1-μ2
+	PGreater           float64 // P(μ1 > μ2)
+	CohenD             float64
+	CohenDLo, CohenDHi float64 // Normal approximation interval of Cohen's d
+	ROPE               float64 // δ of the region of practical equivalence [-δ, δ]
+	PInROPE            float64 // P(-δ <= μ1-μ2 <= δ)
+	Equivalent         bool    // TOST at level α: the (1-2α) credible interval lies inside [-δ, δ]
+}
+
+// NormalTwoSampleReport returns the posterior of μ1-μ2 and its (1-α) credible interval, P(μ1 > μ2), Cohen's d with its
+// (1-α) interval, and the equivalence decision at level α for the margin δ, from the size, mean and standard deviation
+// of each sample. equalVar selects the pooled route of NormalMuDiffCrIJPriEqVar, otherwise the Behrens-Fisher route
+// of NormalMuDiffCrIJPriUn. Each field is what the dedicated function returns.
+func NormalTwoSampleReport(n1 int, ȳ1, s1 float64, n2 int, ȳ2, s2 float64, α, δ float64, equalVar bool) NormalMuDiffReport {
+	// Arguments:
+	// n1, ȳ1, s1	size, mean and standard deviation of the first sample
+	// n2, ȳ2, s2	size, mean and standard deviation of the second sample
+	// α		probability outside the intervals, and level of the equivalence test
+	// δ		half width of the region of practical equivalence
+	// equalVar	whether the two variances are taken as equal
+	if !(α > 0 && α < 0.5) {
+		panic(fmt.Sprintf("α must be in (0, 0.5)"))
+	}
+	if !(δ > 0) {
+		panic(fmt.Sprintf("δ must be greater than zero"))
+	}
+	var rep NormalMuDiffReport
+	rep.EqualVar = equalVar
+	rep.Mean, rep.Scale, rep.Df = normalMuDiffJPri(n1, n2, ȳ1, ȳ2, s1, s2, equalVar)
+	if equalVar {
+		rep.CrILo, rep.CrIHi = NormalMuDiffCrIJPriEqVar(n1, n2, ȳ1, ȳ2, s1, s2, α)
+	} else {
+		rep.CrILo, rep.CrIHi = NormalMuDiffCrIJPriUn(n1, n2, ȳ1, ȳ2, s1, s2, α)
+	}
+	rep.PGreater = NormalMuDiffProbGreaterJPri(n1, n2, ȳ1, ȳ2, s1, s2, equalVar)
+	rep.CohenD, rep.CohenDLo, rep.CohenDHi = NormalCohenD(n1, n2, ȳ1, ȳ2, s1, s2, α)
+	rep.ROPE = δ
+	rep.PInROPE = NormalMuDiffROPEProbJPri(n1, n2, ȳ1, ȳ2, s1, s2, δ, equalVar)
+	rep.Equivalent, _ = NormalMuDiffEquivTstJPri(n1, n2, ȳ1, ȳ2, s1, s2, -δ, δ, α, equalVar)
+	return rep
+}
@@ -9,6 +9,44 @@ import (
 	"math"
 )
 
+// checkNormσ panics unless the population standard deviation σ is positive.
+func checkNormσ(σ float64) {
+	if !(σ > 0) {
+		panic(fmt.Sprintf("Population standard deviation σ must be greater than zero"))
+	}
+}
+
+// checkNormσPri panics unless the prior standard deviation σPri is positive.
+func checkNormσPri(σPri float64) {
+	if !(σPri > 0) {
+		panic(fmt.Sprintf("Prior standard deviation σPri must be greater than zero"))
+	}
+}
+
+// checkNObs panics unless the number of observations is at least min.
+func checkNObs(nObs, min int) {
+	if nObs < min {
+		panic(fmt.Sprintf("number of observations nObs must be at least %d", min))
+	}
+}
+
+// checkDPri panics unless the discrete prior matches μ in length, and its masses are non-negative, finite, and not all zero.
+func checkDPri(μ, μPri []float64) {
+	if len(μPri) != len(μ) {
+		panic(fmt.Sprintf("len(μ) != len(μPri)"))
+	}
+	sum := 0.0
+	for _, m := range μPri {
+		if !(m >= 0) || isInf(m, 1) {
+			panic(fmt.Sprintf("prior masses μPri must be non-negative and finite"))
+		}
+		sum += m
+	}
+	if sum == 0 {
+		panic(fmt.Sprintf("prior masses μPri must not be all zero"))
+	}
+}
+
 // PMF of the posterior distribution of unknown Normal μ, with KNOWN σ, and discrete prior, for single observation. 
 // Bolstad 2007 (2e): 200-201.
 func NormMuSinglePMFDPri(y, σ float64, μ []float64, μPri []float64) (post []float64) {
@@ -16,22 +54,22 @@ func NormMuSinglePMFDPri(y, σ float64, μ []float64, μPri []float64) (post []f
 	// σ	standard deviation of population, assumed to be known
 	// μ	array of possible discrete values of μ
 	// μPri	array of associated prior probability masses
-	nPoss := len(μ)
-	if len(μPri) != nPoss {
-		panic(fmt.Sprintf("len(μ) != len(μPri)"))
-	}
-	post = make([]float64, nPoss)
-	sum := 0.0
-	for i := 0; i < nPoss; i++ {
-		z := (y - μ[i]) / σ
-		like := ZPDFAt(z)
-		post[i] = μPri[i] * like
-		sum += post[i]
-	}
-	for i := 0; i < nPoss; i++ {
-		post[i] /= sum
+	checkDPri(μ, μPri)
+	checkFinite("y", y)
+	checkNormσ(σ)
+	return normMuPMFDPri(1, y, σ, μ, μPri)
+}
+
+// normMuPMFDPri returns the posterior masses of μ on the grid, for checked arguments. The sample mean of n
+// observations is Normal(μ, σ/√n), so that a single observation is the case n = 1, and n = 0 leaves the prior.
+func normMuPMFDPri(n, ȳ, σ float64, μ []float64, μPri []float64) []float64 {
+	se := σ / math.Sqrt(n)
+	logw := make([]float64, len(μ))
+	for i := range μ {
+		z := (ȳ - μ[i]) / se
+		logw[i] = math.Log(μPri[i]) - z*z/2
 	}
-	return
+	return NormalizeLogWeights(logw)
 }
 
 // PMF of the posterior distribution of unknown Normal μ, with KNOWN σ, and discrete prior, for sample
@@ -42,24 +80,11 @@ func NormMuPMFDPri(nObs int, ȳ, σ float64, μ []float64, μPri []float64) (pos
 	// σ		standard deviation of population, assumed to be known
 	// μ		array of possible discrete values of μ
 	// μPri		array of associated prior probability masses
-	nPoss := len(μ) // number of possible values of the parameter μ
-	if len(μPri) != nPoss {
-		panic(fmt.Sprintf("len(μ) != len(μPri)"))
-	}
-	post = make([]float64, nPoss)
-	n := float64(nObs)
-	sum := 0.0
-	for i := 0; i < nPoss; i++ {
-		σ2 := σ * σ
-		ẟ := ȳ - μ[i]
-		like := math.Exp(-1 / (2 * σ2 / n) * ẟ * ẟ)
-		post[i] = μPri[i] * like
-		sum += post[i]
-	}
-	for i := 0; i < nPoss; i++ {
-		post[i] /= sum
-	}
-	return
+	checkDPri(μ, μPri)
+	checkNObs(nObs, 0)
+	checkFinite("ȳ", ȳ)
+	checkNormσ(σ)
+	return normMuPMFDPri(float64(nObs), ȳ, σ, μ, μPri)
 }
 
 // Posterior mean for unknown Normal μ, with KNOWN σ. 
@@ -69,6 +94,8 @@ func NormMuPostMean(nObs int, ȳ, σ, μPri, σPri float64) float64 {
 	// σPri		prior standard deviation
 	// nObs			size of sample == number of measurements
 	// σ		standard deviation of population, assumed to be known (alternatively, use an estimate)
+	checkNormσ(σ)
+	checkNormσPri(σPri)
 	σ2 := σ * σ
 	n := float64(nObs)
 	σ2Pri := σPri * σPri
@@ -83,6 +110,8 @@ func NormMuPostStd(nObs int, σ, μPri, σPri float64) float64 {
 	// σPri		prior standard deviation
 	// nObs		size of sample == number of measurements
 	// σ		standard deviation of population, assumed to be known (alternatively, use an estimate)
+	checkNormσ(σ)
+	checkNormσPri(σPri)
 	σ2 := σ * σ
 	n := float64(nObs)
 	σ2Pri := σPri * σPri
@@ -91,6 +120,39 @@ func NormMuPostStd(nObs int, σ, μPri, σPri float64) float64 {
 	return (σPost)
 }
 
+// NormMuPostNPri returns the posterior of unknown Normal μ, with KNOWN σ, and Normal prior, as a NormalDist.
+// It can be fed back as the prior for the next batch of data.
+// Bolstad 2007 (2e): 209, eq. 11.5-11.6
+func NormMuPostNPri(nObs int, ȳ, σ, μPri, σPri float64) NormalDist {
+	return NormalDist{Mu: NormMuPostMean(nObs, ȳ, σ, μPri, σPri), Sigma: NormMuPostStd(nObs, σ, μPri, σPri)}
+}
+
+// NormMuUpdate returns the posterior mean and standard deviation of unknown Normal μ, with KNOWN σ, and Normal prior,
+// after the observations newObs. The prior may itself be the posterior of earlier data, so observations can be
+// folded in as they arrive; the result does not depend on how they are split into batches.
+// With no new observations the prior is returned unchanged.
+// Bolstad 2007 (2e): 209, eq. 11.5, 11.6
+func NormMuUpdate(μPri, σPri, σ float64, newObs []float64) (μPost, σPost float64) {
+	// μPri		current mean of μ
+	// σPri		current standard deviation of μ
+	// σ		standard deviation of population, assumed to be known
+	// newObs		new observations
+	checkNormσ(σ)
+	checkNormσPri(σPri)
+	checkFinite("newObs", newObs...)
+	if len(newObs) == 0 {
+		return μPri, σPri
+	}
+	ȳ := 0.0
+	for _, y := range newObs {
+		ȳ += y
+	}
+	ȳ /= float64(len(newObs))
+	μPost = NormMuPostMean(len(newObs), ȳ, σ, μPri, σPri)
+	σPost = NormMuPostStd(len(newObs), σ, μPri, σPri)
+	return
+}
+
 // Quantile for posterior distribution of unknown Normal μ, with KNOWN σ, and flat prior (Jeffrey's prior), for single observation
 // Bolstad 2007 (2e): 206
 func NormMuSingleQtlFPri(y, σ, p float64) float64 {
@@ -98,6 +160,7 @@ func NormMuSingleQtlFPri(y, σ, p float64) float64 {
 	// σ		standard deviation of population, assumed to be known
 	// p		probability for which the quantile will be returned
 	// untested ...
+	checkNormσ(σ)
 	μPost := y
 	σPost := σ
 	qtl := NormalQtlFor(μPost, σPost, p)
@@ -112,9 +175,8 @@ func NormMuQtlFPri(nObs int, ȳ, σ, p float64) float64 {
 	// nObs		number of observations
 	// p		probability for which the quantile will be returned
 
-	if σ <= 0 {
-		panic(fmt.Sprintf("Prior standard deviation must be greater than zero"))
-	}
+	checkNObs(nObs, 1)
+	checkNormσ(σ)
 
 	n := float64(nObs)
 	σ2 := σ * σ
@@ -133,9 +195,8 @@ func NormMuSingleQtlNPri(y, σ, μPri, σPri, p float64) float64 {
 	// σPri	Normal prior standard deviation
 	// p		probability for which the quantile will be returned
 	// untested ...
-	if σ <= 0 {
-		panic(fmt.Sprintf("Prior standard deviation must be greater than zero"))
-	}
+	checkNormσ(σ)
+	checkNormσPri(σPri)
 
 	σ2 := σ * σ
 	σ2Pri := σPri * σPri
@@ -154,6 +215,9 @@ func NormMuQtlNPri(nObs int, ȳ, σ, μPri, σPri, p float64) float64 {
 	// μPri		Normal prior mean
 	// σPri		Normal prior standard deviation
 	// p			probability for which the quantile will be returned
+	checkNObs(nObs, 0)
+	checkNormσ(σ)
+	checkNormσPri(σPri)
 	n := float64(nObs)
 	σ2 := σ * σ
 	σ2Pri := σPri * σPri
@@ -172,6 +236,9 @@ func NormMuCrINPriKnown(nObs int, ȳ, σ, μPri, σPri, α float64) (lo, hi floa
 	// μPri		Normal prior mean
 	// σPri		Normal prior standard deviation
 	// α		posterior probability that the true μ lies outside the credible interval
+	checkNObs(nObs, 0)
+	checkNormσ(σ)
+	checkNormσPri(σPri)
 	n := float64(nObs)
 	σ2 := σ * σ
 	σ2Pri := σPri * σPri
@@ -179,35 +246,32 @@ func NormMuCrINPriKnown(nObs int, ȳ, σ, μPri, σPri, α float64) (lo, hi floa
 	μPost := (μPri/σ2Pri)/(n/σ2+1/σ2Pri) + ȳ*(n/σ2)/(n/σ2+1/σ2Pri)
 	//	μPost := (μPri/σ2Pri)/(n*ȳ/σ2+1/σ2Pri) + ((n / σ2) / (n/σ2 + 1/σ2Pri))
 	σPost := math.Sqrt(σ2Post)
-	lo = NormalQtlFor(μPost, σPost, α/2)
-	hi = NormalQtlFor(μPost, σPost, 1-α/2)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = NormalQtlFor(μPost, σPost, αLo)
+	hi = NormalQtlFor(μPost, σPost, αHi)
 	return lo, hi
 }
 
-/* waiting for StudentsTQtlFor() to be implemented
 // Credible interval for unknown Normal μ, with UNKNOWN σ, and Normal prior, equal tail area
 // Bolstad 2007 (2e): 212, eq. 11.8
 func NormMuCrINPriUnkn(nObs int, ȳ, sampσ, μPri, σPri, α float64) (lo, hi float64) {
-// nObs			number of observations
-// ȳ		sample mean of observations taken from Normal distribution
-// sampσ	standard deviation of the sample
-// μPri		Normal prior mean
-// σPri		Normal prior standard deviation
-// α		posterior probability that the true μ lies outside the credible interval
-// untested ...
-	n := float64(nObs)
+	// nObs		number of observations
+	// ȳ		sample mean of observations taken from Normal distribution
+	// sampσ	standard deviation of the sample
+	// μPri		Normal prior mean
+	// σPri		Normal prior standard deviation
+	// α		posterior probability that the true μ lies outside the credible interval
+	if nObs < 2 {
+		panic(fmt.Sprintf("nObs must be at least 2"))
+	}
 	nu := float64(nObs - 1)
-	sampvar := sampσ * sampσ
-	σ2Pri := σPri * σPri
-	σ2Post := (sampvar * σ2Pri) / (sampvar + n*σ2Pri)
-	μPost := (μPri/σ2Pri)/(n*ȳ/sampvar+1/σ2Pri) + ((n / sampvar) / (n/sampvar + 1/σ2Pri))
-	σPost := math.Sqrt(σ2Post)
-	t := StudentsTQtlFor(α/2, nu)
-	lo = μPost - t*σPost
-	hi = μPost + t*σPost
+	μPost := NormMuPostMean(nObs, ȳ, sampσ, μPri, σPri)
+	σPost := NormMuPostStd(nObs, sampσ, μPri, σPri)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = μPost + StudentsTQtlFor(nu, αLo)*σPost
+	hi = μPost + StudentsTQtlFor(nu, αHi)*σPost
 	return lo, hi
 }
-*/
 
 // Credible interval for unknown Normal μ, with KNOWN σ, and flat prior
 // Bolstad 2007 (2e): 212, eq. 11.7
@@ -217,32 +281,75 @@ func NormMuCrIFPriKnown(nObs int, ȳ, σ, α float64) (lo, hi float64) {
 	// σ		standard deviation of population, assumed to be known
 	// nObs		number of observations
 	// α		posterior probability that the true μ lies outside the credible interval
+	checkNormσ(σ)
 	n := float64(nObs)
 	μPost := ȳ
 	σ2Post := (σ * σ / n)
 	σPost := math.Sqrt(σ2Post)
-	lo = NormalQtlFor(μPost, σPost, α/2)
-	hi = NormalQtlFor(μPost, σPost, 1-α/2)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = NormalQtlFor(μPost, σPost, αLo)
+	hi = NormalQtlFor(μPost, σPost, αHi)
 	return lo, hi
 }
 
-/* waiting for StudentsTQtlFor() to be implemented
 // Credible interval for unknown Normal μ, with UNKNOWN σ, and flat prior
 // Bolstad 2007 (2e): 212, eq. 11.8
 func NormMuCrIFPriUnkn(nObs int, ȳ, σ, α float64) (lo, hi float64) {
-// ȳ		sample mean of observations taken from Normal distribution
-// σ		standard deviation of population, unknown
-// nObs		number of observations
-// α		posterior probability that the true μ lies outside the credible interval
-// untested ...
+	// ȳ		sample mean of observations taken from Normal distribution
+	// σ		standard deviation of the sample
+	// nObs		number of observations
+	// α		posterior probability that the true μ lies outside the credible interval
+	if nObs < 2 {
+		panic(fmt.Sprintf("nObs must be at least 2"))
+	}
+	checkNormσ(σ)
 	n := float64(nObs)
 	nu := float64(nObs - 1)
 	μPost := ȳ
 	σ2Post := (σ * σ / n)
 	σPost := math.Sqrt(σ2Post)
-	t := StudentsTQtlFor(α/2, nu)
-	lo = μPost - t*σPost
-	hi = μPost + t*σPost
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = μPost + StudentsTQtlFor(nu, αLo)*σPost
+	hi = μPost + StudentsTQtlFor(nu, αHi)*σPost
 	return lo, hi
 }
-*/
+
+// normMuPred returns the mean and standard deviation of the posterior predictive distribution of the next observation,
+// Normal(μPost, σPost² + σ²): the uncertainty about μ adds to the sampling variance.
+func normMuPred(nObs int, ȳ, σ, μPri, σPri float64) (μ, sd float64) {
+	if nObs < 0 {
+		panic(fmt.Sprintf("nObs must be non-negative"))
+	}
+	μ = NormMuPostMean(nObs, ȳ, σ, μPri, σPri)
+	σPost := NormMuPostStd(nObs, σ, μPri, σPri)
+	sd = math.Sqrt(σPost*σPost + σ*σ)
+	return
+}
+
+// PDF of the posterior predictive distribution of the next observation, Normal with KNOWN σ, and Normal prior of μ, for sample
+func NormMuPredPDF(nObs int, ȳ, σ, μPri, σPri float64) func(x float64) float64 {
+	// ȳ		sample mean of observations taken from Normal distribution
+	// σ		standard deviation of population, assumed to be known
+	// nObs		number of observations
+	// μPri		prior mean
+	// σPri		prior standard deviation
+	μ, sd := normMuPred(nObs, ȳ, σ, μPri, σPri)
+	return NormalPDF(μ, sd)
+}
+
+// CDF of the posterior predictive distribution of the next observation, Normal with KNOWN σ, and Normal prior of μ, for sample
+func NormMuPredCDF(nObs int, ȳ, σ, μPri, σPri float64) func(x float64) float64 {
+	μ, sd := normMuPred(nObs, ȳ, σ, μPri, σPri)
+	return NormalCDF(μ, sd)
+}
+
+// Quantile function of the posterior predictive distribution of the next observation, Normal with KNOWN σ, and Normal prior of μ, for sample
+func NormMuPredQtl(nObs int, ȳ, σ, μPri, σPri float64) func(p float64) float64 {
+	μ, sd := normMuPred(nObs, ȳ, σ, μPri, σPri)
+	return NormalQtl(μ, sd)
+}
+
+// PDF of the posterior predictive distribution of the next observation, Normal with KNOWN σ, and Normal prior of μ, for single observation
+func NormMuSinglePredPDF(y, σ, μPri, σPri float64) func(x float64) float64 {
+	return NormMuPredPDF(1, y, σ, μPri, σPri)
+}
@@ -0,0 +1,46 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Bayesian inference about the mean difference of paired observations from Normal distributions.
+// The differences d = x - y are treated as one sample with UNKNOWN σ; with flat prior for the mean difference μd
+// and Jeffreys' prior for σ, the posterior of μd is Student's t with n-1 degrees of freedom,
+// location d̄ and scale s/√n, s the standard deviation of the differences.
+// Bolstad 2007 (2e): 255-258.
+
+import (
+	"fmt"
+	"math"
+)
+
+// NormalPairedDiffPost returns the location, scale and degrees of freedom of the Student's t posterior of the mean difference of paired observations.
+func NormalPairedDiffPost(x, y []float64) (μPost, σPost, ν float64) {
+	// x, y		paired observations, x[i] and y[i] taken on the same unit
+	if len(x) != len(y) {
+		panic(fmt.Sprintf("len(x) != len(y)"))
+	}
+	d := make([]float64, len(x))
+	for i := range d {
+		d[i] = x[i] - y[i]
+	}
+	n, dbar, ss := sumSq(d)
+	if n < 2 {
+		panic(fmt.Sprintf("at least two pairs needed"))
+	}
+	return NormalPairedDiffPostStats(n, dbar, ss/float64(n-1))
+}
+
+// NormalPairedDiffPostStats is NormalPairedDiffPost for summary statistics of the differences:
+// number of pairs n, mean difference dbar, and sample variance of the differences v (with divisor n-1).
+func NormalPairedDiffPostStats(n int, dbar, v float64) (μPost, σPost, ν float64) {
+	if n < 2 {
+		panic(fmt.Sprintf("at least two pairs needed"))
+	}
+	if v < 0 {
+		panic(fmt.Sprintf("sample variance must be non-negative"))
+	}
+	μPost = dbar
+	σPost = math.Sqrt(v / float64(n))
+	ν = float64(n - 1)
+	return
+}
@@ -0,0 +1,70 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Normal distribution of an unknown Normal mean μ as a value, for conjugate updating with KNOWN σ
+// without passing (μPri, σPri) around. The prior and every posterior are the same type.
+// Bolstad 2007 (2e): Chapter 11.
+
+import (
+	. "github.com/datastream/probab/dst"
+	"math"
+)
+
+// NormalPrior is the Normal(Mu, Sigma²) distribution of an unknown Normal mean.
+type NormalPrior struct {
+	Mu, Sigma float64
+}
+
+// Update returns the posterior after nObs observations with sample mean ȳ, from a population with KNOWN σ.
+// Bolstad 2007 (2e): 209, eq. 11.5-11.6
+func (g NormalPrior) Update(nObs int, ȳ, σ float64) NormalPrior {
+	checkNObs(nObs, 0)
+	return NormalPrior{NormMuPostMean(nObs, ȳ, σ, g.Mu, g.Sigma), NormMuPostStd(nObs, σ, g.Mu, g.Sigma)}
+}
+
+// Diff returns the distribution of μ1-μ2, for independent μ1 ~ g and μ2 ~ h.
+func (g NormalPrior) Diff(h NormalPrior) NormalPrior {
+	return NormalPrior{g.Mu - h.Mu, math.Sqrt(g.Sigma*g.Sigma + h.Sigma*h.Sigma)}
+}
+
+// PDF returns the PDF of μ.
+func (g NormalPrior) PDF() func(x float64) float64 {
+	checkNormσPri(g.Sigma)
+	return NormalPDF(g.Mu, g.Sigma)
+}
+
+// CDF returns the CDF of μ.
+func (g NormalPrior) CDF() func(x float64) float64 {
+	checkNormσPri(g.Sigma)
+	return NormalCDF(g.Mu, g.Sigma)
+}
+
+// Qtl returns the quantile function of μ.
+func (g NormalPrior) Qtl() func(p float64) float64 {
+	checkNormσPri(g.Sigma)
+	return NormalQtl(g.Mu, g.Sigma)
+}
+
+// CrI returns the equal tail area credible interval of μ.
+func (g NormalPrior) CrI(α float64) (lo, hi float64) {
+	// α		probability that μ lies outside the credible interval
+	qtl := g.Qtl()
+	αLo, αHi := TailsFromConfidence(1 - α)
+	return qtl(αLo), qtl(αHi)
+}
+
+// Mean returns the mean of μ.
+func (g NormalPrior) Mean() float64 {
+	return g.Mu
+}
+
+// Std returns the standard deviation of μ.
+func (g NormalPrior) Std() float64 {
+	return g.Sigma
+}
+
+// String returns the distribution as NormalDist formats it, e.g. "Normal(μ=1.2, σ=0.3)".
+func (g NormalPrior) String() string {
+	return NormalDist{Mu: g.Mu, Sigma: g.Sigma}.String()
+}
@@ -0,0 +1,164 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Bayesian inference about the variance σ² of Normal distribution, with unknown mean.
+// The conjugate prior of σ² is InvGamma(α, β); the posterior is InvGamma(α + (nObs-1)/2, β + (nObs-1)s²/2),
+// where s² is the sample variance with divisor nObs-1.
+// The scaled inverse ChiSquare(ν, s²) distribution is InvGamma(ν/2, νs²/2); as a prior, it reads as
+// ν prior observations with variance s². The NormVar functions use this parametrization, and the
+// NormSigmaSq functions of the InvGamma prior are built on them.
+// Bolstad 2007 (2e): Chapter 15, p. 299 and further.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+)
+
+// NormSigmaSqCrIJPri returns the equal tail credible interval of Normal σ², Jeffreys' prior g(σ²) ∝ 1/σ².
+// The posterior of σ² is S times inverse ChiSquare(nObs-1), S = (nObs-1)s².
+// Bolstad 2007 (2e): 301-303.
+func NormSigmaSqCrIJPri(nObs int, sampleVar, α float64) (lo, hi float64) {
+	// nObs		number of observations
+	// sampleVar	sample variance s², with divisor nObs-1
+	// α		posterior probability that σ² lies outside the credible interval
+	if nObs < 2 {
+		panic(fmt.Sprintf("nObs must be at least 2"))
+	}
+	if sampleVar <= 0 {
+		panic(fmt.Sprintf("sample variance must be positive"))
+	}
+	αLo, αHi := TailsFromConfidence(1 - α)
+	df := int64(nObs - 1)
+	ss := float64(df) * sampleVar
+	lo = ss / ChiSquareQtlFor(df, αHi)
+	hi = ss / ChiSquareQtlFor(df, αLo)
+	return
+}
+
+// normSigmaSqPost returns the degrees of freedom and scale of the scaled inverse ChiSquare posterior of σ²,
+// InvGamma(α + (nObs-1)/2, β + (nObs-1)s²/2), checking the arguments.
+func normSigmaSqPost(nObs int, sampleVar, α, β float64) (df, scale float64) {
+	if nObs < 2 {
+		panic(fmt.Sprintf("nObs must be at least 2"))
+	}
+	if !(sampleVar > 0) || isInf(sampleVar, 0) {
+		panic(fmt.Sprintf("sample variance must be positive"))
+	}
+	if !(α >= 0 && β >= 0) || isInf(α, 0) || isInf(β, 0) {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+	}
+	ν := float64(nObs - 1)
+	df = 2*α + ν
+	return df, (2*β + ν*sampleVar) / df
+}
+
+// NormSigmaSqPDFIGPri returns the posterior PDF of Normal σ², Inverse-Gamma prior.
+// α = β = 0 is Jeffreys' prior g(σ²) ∝ 1/σ².
+func NormSigmaSqPDFIGPri(nObs int, sampleVar, α, β float64) func(x float64) float64 {
+	// nObs		number of observations
+	// sampleVar	sample variance s², with divisor nObs-1
+	// α		InvGamma prior shape
+	// β		InvGamma prior scale
+	return NormVarPostPDF(normSigmaSqPost(nObs, sampleVar, α, β))
+}
+
+// NormSigmaSqCDFIGPri returns the posterior CDF of Normal σ², Inverse-Gamma prior.
+func NormSigmaSqCDFIGPri(nObs int, sampleVar, α, β float64) func(x float64) float64 {
+	return NormVarPostCDF(normSigmaSqPost(nObs, sampleVar, α, β))
+}
+
+// NormSigmaSqQtlIGPri returns the posterior quantile function of Normal σ², Inverse-Gamma prior.
+func NormSigmaSqQtlIGPri(nObs int, sampleVar, α, β float64) func(p float64) float64 {
+	return NormVarPostQtl(normSigmaSqPost(nObs, sampleVar, α, β))
+}
+
+// NormSigmaSqCrIIGPri returns the equal tail credible interval of Normal σ², Inverse-Gamma prior.
+// Under Jeffreys' prior, α = β = 0, it is the interval of NormSigmaSqCrIJPri.
+func NormSigmaSqCrIIGPri(nObs int, sampleVar, α, β, alpha float64) (lo, hi float64) {
+	// alpha	posterior probability that σ² lies outside the credible interval
+	df, scale := normSigmaSqPost(nObs, sampleVar, α, β)
+	return NormVarPostCrI(df, scale, alpha)
+}
+
+// NormSigmaSqPDFJPri returns the posterior PDF of Normal σ², Jeffreys' prior g(σ²) ∝ 1/σ².
+func NormSigmaSqPDFJPri(nObs int, sampleVar float64) func(x float64) float64 {
+	return NormSigmaSqPDFIGPri(nObs, sampleVar, 0, 0)
+}
+
+// NormSigmaSqCDFJPri returns the posterior CDF of Normal σ², Jeffreys' prior.
+func NormSigmaSqCDFJPri(nObs int, sampleVar float64) func(x float64) float64 {
+	return NormSigmaSqCDFIGPri(nObs, sampleVar, 0, 0)
+}
+
+// NormSigmaSqQtlJPri returns the posterior quantile function of Normal σ², Jeffreys' prior.
+func NormSigmaSqQtlJPri(nObs int, sampleVar float64) func(p float64) float64 {
+	return NormSigmaSqQtlIGPri(nObs, sampleVar, 0, 0)
+}
+
+// NormVarPostParams returns the degrees of freedom and scale of the scaled inverse ChiSquare posterior of Normal σ²,
+// with unknown mean, from the sample y and a scaled inverse ChiSquare(priorDf, priorScale) prior of σ², flat prior of μ.
+// df = priorDf + n - 1 and scale = (priorDf·priorScale + (n-1)s²) / df. priorDf = 0 is Jeffreys' prior g(σ²) ∝ 1/σ².
+// Gelman et al. 2004 (2e): Chapter 3.2-3.3.
+func NormVarPostParams(y []float64, priorDf, priorScale float64) (df, scale float64) {
+	// y		sample, at least 2 observations
+	// priorDf	prior degrees of freedom, >= 0
+	// priorScale	prior scale, >= 0
+	if len(y) < 2 {
+		panic(fmt.Sprintf("at least 2 observations are needed"))
+	}
+	checkFinite("y", y...)
+	if !(priorDf >= 0 && priorScale >= 0) || isInf(priorDf, 0) || isInf(priorScale, 0) {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative"))
+	}
+	_, sd := meanSd(y)
+	n := float64(len(y))
+	df = priorDf + n - 1
+	scale = (priorDf*priorScale + (n-1)*sd*sd) / df
+	if !(scale > 0) {
+		panic(fmt.Sprintf("posterior is improper: all observations are equal, and the prior scale is zero"))
+	}
+	return
+}
+
+// checkNormVarPost panics unless df and scale are those of a proper scaled inverse ChiSquare distribution.
+func checkNormVarPost(df, scale float64) {
+	if !(df > 0 && scale > 0) || isInf(df, 0) || isInf(scale, 0) {
+		panic(fmt.Sprintf("degrees of freedom df and scale must be greater than zero"))
+	}
+}
+
+// NormVarPostPDF returns the PDF of the scaled inverse ChiSquare(df, scale) posterior of Normal σ².
+func NormVarPostPDF(df, scale float64) func(x float64) float64 {
+	checkNormVarPost(df, scale)
+	return InvGammaPDF(df/2, df*scale/2)
+}
+
+// NormVarPostCDF returns the CDF of the scaled inverse ChiSquare(df, scale) posterior of Normal σ².
+func NormVarPostCDF(df, scale float64) func(x float64) float64 {
+	checkNormVarPost(df, scale)
+	return InvGammaCDF(df/2, df*scale/2)
+}
+
+// NormVarPostQtl returns the quantile function of the scaled inverse ChiSquare(df, scale) posterior of Normal σ².
+func NormVarPostQtl(df, scale float64) func(p float64) float64 {
+	checkNormVarPost(df, scale)
+	return InvGammaQtl(df/2, df*scale/2)
+}
+
+// NormVarPostMean returns the posterior mean df·scale/(df-2) of Normal σ²; +Inf for df <= 2.
+func NormVarPostMean(df, scale float64) float64 {
+	checkNormVarPost(df, scale)
+	if df <= 2 {
+		return posInf
+	}
+	return df * scale / (df - 2)
+}
+
+// NormVarPostCrI returns the equal tail credible interval of Normal σ², scaled inverse ChiSquare(df, scale) posterior.
+func NormVarPostCrI(df, scale, α float64) (lo, hi float64) {
+	// α		posterior probability that σ² lies outside the credible interval
+	qtl := NormVarPostQtl(df, scale)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	return qtl(αLo), qtl(αHi)
+}
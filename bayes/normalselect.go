@@ -0,0 +1,34 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Selection of Normal Prior Given Knowledge of a Central Interval.
+// Ref.: Bolstad 2007 (2e): Chapter 11, p. 209-210.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+)
+
+// NormalPriorFromInterval finds the parameters of a Normal prior whose central credible interval
+// with probability content conf is [lo, hi], e.g. "I'm 95% sure μ is between 10 and 20".
+// The result can be fed directly to NormMuQtlNPri and the Normal difference functions.
+func NormalPriorFromInterval(lo, hi, conf float64) (μPri, σPri float64) {
+	// Arguments:
+	// lo	lower boundary of the interval
+	// hi	upper boundary of the interval
+	// conf	prior probability that μ lies within [lo, hi]
+	// Returns:
+	// μPri, σPri	params of the corresponding Normal prior.
+
+	if hi <= lo {
+		panic(fmt.Sprintf("upper boundary hi must be greater than lower boundary lo"))
+	}
+	if conf <= 0 || conf >= 1 {
+		panic(fmt.Sprintf("probability content conf must be in (0, 1)"))
+	}
+	z := dst.ZQtlFor(0.5 + conf/2)
+	μPri = (lo + hi) / 2
+	σPri = (hi - lo) / (2 * z)
+	return
+}
@@ -6,6 +6,7 @@ package bayes
 // Ref.: Albert (2009)
 
 import (
+	"fmt"
 	"github.com/datastream/probab/dst"
 )
 
@@ -13,6 +14,30 @@ func rigamma(shape, rate float64) float64 {
 	return (1 / dst.GammaNext(shape, 1/rate))
 }
 
+// sumSq returns the number of observations, their mean, and the sum of squared deviations from the mean.
+func sumSq(data []float64) (n int, xbar, ss float64) {
+	n = len(data)
+	xbar = mean(data)
+	for _, val := range data {
+		ss += (val - xbar) * (val - xbar)
+	}
+	return
+}
+
+// statsSumSq returns the sum of squared deviations from the mean, for sample variance v of n observations.
+func statsSumSq(n int, v float64) float64 {
+	if n < 1 {
+		panic(fmt.Sprintf("number of observations n must be greater than zero"))
+	}
+	if v < 0 {
+		panic(fmt.Sprintf("sample variance must be non-negative"))
+	}
+	if n == 1 {
+		return 0
+	}
+	return float64(n-1) * v
+}
+
 // NormPostSim returns a simulated sample from the joint posterior distribution of the mean and variance for a normal
 // sampling prior with a noninformative or informative prior. The prior assumes mu and sigma2 are
 // independent with mu assigned a normal prior with mean mu0 and variance tau2, and sigma2 is
@@ -30,14 +55,17 @@ func NormPostSim(data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2
 	// mu - vector of simulated draws of normal mean
 	// sigma2 - vector of simulated draws of normal variance
 
-	xbar := mean(data)
-	n := len(data)
-	diff2 := make([]float64, n)
-	for i, val := range data {
-		diff2[i] = (val - xbar) * (val - xbar)
-	}
+	n, xbar, s := sumSq(data)
+	return normPostSim(n, xbar, s, a, b, mu0, tau2, m)
+}
+
+// NormPostSimStats is NormPostSim for summary statistics: number of observations n, sample mean xbar
+// and sample variance v (with divisor n-1).
+func NormPostSimStats(n int, xbar, v, a, b, mu0, tau2 float64, m int) (postMu, postS2 []float64) {
+	return normPostSim(n, xbar, statsSumSq(n, v), a, b, mu0, tau2, m)
+}
 
-	s := sum(diff2)
+func normPostSim(n int, xbar, s, a, b, mu0, tau2 float64, m int) (postMu, postS2 []float64) {
 	postS2 = make([]float64, m)
 	postMu = make([]float64, m)
 	sigma2 := s / float64(n)
@@ -51,12 +79,8 @@ func NormPostSim(data []float64, a, b, mu0, tau2 float64, m int) (postMu, postS2
 
 		a1 := a + float64(n)/2
 
-		d2 := make([]float64, n)
-		for i, val := range data {
-			d2[i] = (val - mu) * (val - mu)
-		}
-
-		b1 := b + sum(d2)/2
+		// sum((data-mu)^2) = s + n*(xbar-mu)^2
+		b1 := b + (s+float64(n)*(xbar-mu)*(xbar-mu))/2
 		sigma2 := rigamma(a1, b1)
 
 		postS2[j] = sigma2
@@ -75,15 +99,17 @@ func NormPostSimNoPrior(data []float64, m int) (postMu, postS2 []float64) {
 	// mu - vector of simulated draws of normal mean
 	// sigma2 - vector of simulated draws of normal variance
 
-	xbar := mean(data)
-	n := len(data)
-	diff2 := make([]float64, n)
+	n, xbar, s := sumSq(data)
+	return normPostSimNoPrior(n, xbar, s, m)
+}
 
-	for i, val := range data {
-		diff2[i] = (val - xbar) * (val - xbar)
-	}
+// NormPostSimNoPriorStats is NormPostSimNoPrior for summary statistics: number of observations n, sample mean xbar
+// and sample variance v (with divisor n-1).
+func NormPostSimNoPriorStats(n int, xbar, v float64, m int) (postMu, postS2 []float64) {
+	return normPostSimNoPrior(n, xbar, statsSumSq(n, v), m)
+}
 
-	s := sum(diff2)
+func normPostSimNoPrior(n int, xbar, s float64, m int) (postMu, postS2 []float64) {
 	postS2 = make([]float64, m)
 	postMu = make([]float64, m)
 
@@ -104,15 +130,17 @@ func NormPostNoPriorNext(data []float64) (postMu, postS2 float64) {
 	// postMu -  simulated draw of normal mean
 	// postS2 -  simulated draw of normal variance
 
-	xbar := mean(data)
-	n := len(data)
-	diff2 := make([]float64, n)
+	n, xbar, s := sumSq(data)
+	return normPostNoPriorNext(n, xbar, s)
+}
 
-	for i, val := range data {
-		diff2[i] = (val - xbar) * (val - xbar)
-	}
+// NormPostNoPriorNextStats is NormPostNoPriorNext for summary statistics: number of observations n, sample mean xbar
+// and sample variance v (with divisor n-1).
+func NormPostNoPriorNextStats(n int, xbar, v float64) (postMu, postS2 float64) {
+	return normPostNoPriorNext(n, xbar, statsSumSq(n, v))
+}
 
-	s := sum(diff2)
+func normPostNoPriorNext(n int, xbar, s float64) (postMu, postS2 float64) {
 	postS2 = s / dst.ChiSquareNext(int64(n)-1)
 	sd := sqrt(postS2) / sqrt(float64(n))
 	postMu = dst.NormalNext(xbar, sd)
@@ -135,14 +163,17 @@ func NormPostInfPriorNext(data []float64, a, b, mu0, tau2 float64) (postMu, post
 	// postMu -  simulated draw of normal mean
 	// postS2 -  simulated draw of normal variance
 
-	xbar := mean(data)
-	n := len(data)
-	diff2 := make([]float64, n)
-	for i, val := range data {
-		diff2[i] = (val - xbar) * (val - xbar)
-	}
+	n, xbar, s := sumSq(data)
+	return normPostInfPriorNext(n, xbar, s, a, b, mu0, tau2)
+}
+
+// NormPostInfPriorNextStats is NormPostInfPriorNext for summary statistics: number of observations n, sample mean xbar
+// and sample variance v (with divisor n-1).
+func NormPostInfPriorNextStats(n int, xbar, v, a, b, mu0, tau2 float64) (postMu, postS2 float64) {
+	return normPostInfPriorNext(n, xbar, statsSumSq(n, v), a, b, mu0, tau2)
+}
 
-	s := sum(diff2)
+func normPostInfPriorNext(n int, xbar, s, a, b, mu0, tau2 float64) (postMu, postS2 float64) {
 	postS2 = s / float64(n)
 	prec := float64(n)/postS2 + 1/tau2
 	mu1 := (xbar*float64(n)/postS2 + mu0/tau2) / prec
@@ -151,12 +182,49 @@ func NormPostInfPriorNext(data []float64, a, b, mu0, tau2 float64) (postMu, post
 
 	a1 := a + float64(n)/2
 
-	d2 := make([]float64, n)
-	for i, val := range data {
-		d2[i] = (val - postMu) * (val - postMu)
+	// sum((data-postMu)^2) = s + n*(xbar-postMu)^2
+	b1 := b + (s+float64(n)*(xbar-postMu)*(xbar-postMu))/2
+	postS2 = rigamma(a1, b1)
+	return
+}
+
+// NormJointPostSample returns draws from the joint Normal-Inverse-ChiSquare posterior of the mean and variance
+// of a Normal sample, conjugate prior μ | σ² ~ N(μ0, σ²/κ0), σ² ~ Inv-χ²(ν0, σ02):
+// first σ² ~ Inv-χ²(νn, σn²), then μ | σ² ~ N(μn, σ²/κn), with
+// κn = κ0+n, μn = (κ0μ0+nȳ)/κn, νn = ν0+n, νnσn² = ν0σ02 + (n-1)s² + κ0n/κn (ȳ-μ0)².
+// The noninformative prior p(μ, σ²) ∝ 1/σ² of NormPostSimNoPrior is the limit κ0 = 0, ν0 = -1, σ02 = 0.
+// Gelman et al. 2004 (2e): Chapter 3.3.
+func NormJointPostSample(nObs int, yBar, sampleVar, μ0, κ0, ν0, σ02 float64, nSamples int) (muSamples, sigmaSqSamples []float64) {
+	// nObs		number of observations
+	// yBar		sample mean
+	// sampleVar	sample variance s², with divisor nObs-1
+	// μ0, κ0	prior mean of μ, and its weight in prior observations
+	// ν0, σ02	prior degrees of freedom and scale of σ²
+	// nSamples	number of draws
+	ss := statsSumSq(nObs, sampleVar)
+	checkFinite("yBar", yBar)
+	checkFinite("μ0", μ0)
+	noninf := κ0 == 0 && ν0 == -1 && σ02 == 0
+	if !noninf && (!(κ0 >= 0 && ν0 >= 0 && σ02 >= 0) || isInf(κ0, 0) || isInf(ν0, 0) || isInf(σ02, 0)) {
+		panic(fmt.Sprintf("The parameters of the prior must be non-negative, or κ0 = 0, ν0 = -1, σ02 = 0"))
+	}
+	if nSamples < 1 {
+		panic(fmt.Sprintf("nSamples must be at least 1"))
+	}
+	n := float64(nObs)
+	κn := κ0 + n
+	μn := (κ0*μ0 + n*yBar) / κn
+	νn := ν0 + n
+	νσ2n := ν0*σ02 + ss + κ0*n/κn*(yBar-μ0)*(yBar-μ0)
+	if !(νn > 0 && νσ2n > 0) {
+		panic(fmt.Sprintf("posterior is improper: too few observations, or all equal, for the prior"))
 	}
 
-	b1 := b + sum(d2)/2
-	postS2 = rigamma(a1, b1)
+	muSamples = make([]float64, nSamples)
+	sigmaSqSamples = make([]float64, nSamples)
+	for i := range muSamples {
+		sigmaSqSamples[i] = νσ2n / dst.GammaNext(νn/2, 2) // νσ2n/χ²(νn)
+		muSamples[i] = dst.NormalNext(μn, sqrt(sigmaSqSamples[i]/κn))
+	}
 	return
 }
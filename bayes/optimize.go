@@ -0,0 +1,147 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Derivative-free maximization of a function of two variables by the Nelder-Mead simplex method.
+// Ref.: Nelder and Mead 1965, Computer Journal 7: 308-313; Lagarias et al. 1998, SIAM J. Optim. 9(1): 112-147.
+
+import (
+	"fmt"
+)
+
+const (
+	optimTol     = 1e-10 // relative spread of the function values over the simplex at convergence
+	optimXTol    = 1e-8  // relative size of the simplex at convergence
+	optimMaxIter = 5000
+)
+
+// Optimize2D returns the point (a, b) where f attains its maximum, and the maximum value, searching from (aInit, bInit).
+// f needs no derivatives. Where f is undefined it may return NaN or -Inf; such points are never accepted,
+// so constraints can be imposed that way, although reparametrizing (e.g. by logs for positive parameters) works better.
+// For a function with several local maxima, the one found depends on the starting point.
+func Optimize2D(f func(a, b float64) float64, aInit, bInit float64) (a, b, value float64) {
+	checkFinite("starting point", aInit, bInit)
+	// minimize g = -f, with NaN treated as +Inf
+	g := func(x [2]float64) float64 {
+		v := -f(x[0], x[1])
+		if isNaN(v) {
+			return posInf
+		}
+		return v
+	}
+
+	// initial simplex: the starting point and steps of 5% (or 0.00025 at zero) along each axis
+	var x [3][2]float64
+	var fx [3]float64
+	x[0] = [2]float64{aInit, bInit}
+	for i := 1; i < 3; i++ {
+		x[i] = x[0]
+		if x[0][i-1] != 0 {
+			x[i][i-1] *= 1.05
+		} else {
+			x[i][i-1] = 0.00025
+		}
+	}
+	for i := range x {
+		fx[i] = g(x[i])
+	}
+	if isInf(fx[0], 1) {
+		panic(fmt.Sprintf("f is not defined at the starting point"))
+	}
+
+	along := func(c, p [2]float64, t float64) [2]float64 {
+		return [2]float64{c[0] + t*(p[0]-c[0]), c[1] + t*(p[1]-c[1])}
+	}
+	for iter := 0; iter < optimMaxIter; iter++ {
+		// order the vertices: x[0] best, x[2] worst
+		for i := 1; i < 3; i++ {
+			for j := i; j > 0 && fx[j] < fx[j-1]; j-- {
+				x[j], x[j-1] = x[j-1], x[j]
+				fx[j], fx[j-1] = fx[j-1], fx[j]
+			}
+		}
+		size := 0.0
+		for i := 1; i < 3; i++ {
+			size = max(size, abs(x[i][0]-x[0][0])/(1+abs(x[0][0])))
+			size = max(size, abs(x[i][1]-x[0][1])/(1+abs(x[0][1])))
+		}
+		if abs(fx[2]-fx[0]) <= optimTol*(abs(fx[0])+optimTol) && size <= optimXTol {
+			break
+		}
+
+		c := along(x[0], x[1], 0.5) // centroid of the two best vertices
+		xr := along(c, x[2], -1)
+		fr := g(xr)
+		switch {
+		case fr < fx[0]: // reflection is the new best: try to expand
+			xe := along(c, x[2], -2)
+			if fe := g(xe); fe < fr {
+				x[2], fx[2] = xe, fe
+			} else {
+				x[2], fx[2] = xr, fr
+			}
+		case fr < fx[1]: // accept the reflection
+			x[2], fx[2] = xr, fr
+		default: // contract, outside or inside
+			t := -0.5
+			if fr >= fx[2] {
+				t = 0.5
+			}
+			xc := along(c, x[2], t)
+			if fc := g(xc); fc < min(fr, fx[2]) {
+				x[2], fx[2] = xc, fc
+				continue
+			}
+			// shrink towards the best vertex
+			for i := 1; i < 3; i++ {
+				x[i] = along(x[0], x[i], 0.5)
+				fx[i] = g(x[i])
+			}
+		}
+	}
+	best := 0
+	for i := 1; i < 3; i++ {
+		if fx[i] < fx[best] {
+			best = i
+		}
+	}
+	return x[best][0], x[best][1], -fx[best]
+}
+
+// PoissonGammaEB returns the empirical Bayes estimates of the shape r and rate v of the Gamma prior
+// of the rates λi, where yi ~ Poisson(ei*λi), by maximizing the marginal (negative binomial) likelihood.
+// The maximum log marginal likelihood is returned as logLik. r and v are searched on the log scale.
+// If the counts show no overdispersion, the maximum is in the Poisson limit and r and v grow without bound.
+// Ref.: Albert 2009 (2e): Chapter 5.
+func PoissonGammaEB(y, e []float64) (r, v, logLik float64) {
+	// Arguments:
+	// y		observed counts
+	// e		exposures
+	if len(y) == 0 || len(y) != len(e) {
+		panic(fmt.Sprintf("y and e must be non-empty and of equal length"))
+	}
+	checkFinite("y", y...)
+	checkFinite("e", e...)
+	sumY, sumE := 0.0, 0.0
+	for i := range y {
+		if y[i] < 0 || !(e[i] > 0) {
+			panic(fmt.Sprintf("counts must be non-negative and exposures positive"))
+		}
+		sumY += y[i]
+		sumE += e[i]
+	}
+	if sumY == 0 {
+		panic(fmt.Sprintf("no events observed: the marginal likelihood has no maximum"))
+	}
+	ll := func(lr, lv float64) float64 {
+		r, v := exp(lr), exp(lv)
+		s := 0.0
+		for i := range y {
+			s += logG(y[i], e[i], r, v)
+		}
+		return s
+	}
+	// start at r = 1 and the prior mean r/v equal to the pooled rate
+	lr, lv, logLik := Optimize2D(ll, 0, log(sumE/sumY))
+	return exp(lr), exp(lv), logLik
+}
@@ -2,6 +2,10 @@
 
 package bayes
 
+import (
+	"fmt"
+)
+
 // PropDisc returns the posterior distribution for a proportion for a discrete prior distribution.
 func PropDisc(p, prior []float64, succ, fail int) []float64 {
 	//Arguments: 
@@ -13,9 +17,14 @@ func PropDisc(p, prior []float64, succ, fail int) []float64 {
 	// vector of posterior probabilities.
 	// Ref.: Albert (2009): Chapter 2.3: 19-22.
 
+	if len(prior) != len(p) {
+		panic(fmt.Sprintf("p and prior must have the same length"))
+	}
+	checkFinite("p", p...)
+	checkFinite("prior", prior...)
+
 	s := float64(succ)
 	f := float64(fail)
-	mx := -1e99
 
 	p1 := make([]float64, len(p))
 	for i, _ := range p {
@@ -32,38 +41,18 @@ func PropDisc(p, prior []float64, succ, fail int) []float64 {
 	}
 
 	for i, _ := range like {
-		if !(p[i] > 0 && p[i] < 1) {
-			if (p[i] == 0 && s > 0) || (p[i] == 1 && f > 0) {
-				like[i] = nan
-			}
-		}
-	}
-
-	for i, _ := range like {
-		if like[i] > mx {
-			mx = like[i]
+		switch {
+		case (p[i] == 0 && s > 0) || (p[i] == 1 && f > 0):
+			like[i] = negInf // impossible value of p
+		case p[i] == 0 || p[i] == 1:
+			like[i] = 0 // p^0 or (1-p)^0
 		}
 	}
 
-	for i, val := range like {
-		like[i] = exp(val - mx)
-	}
-
-	//product=like*prior
-	product := make([]float64, len(p))
-	for i, _ := range product {
-		product[i] = like[i] * prior[i]
-	}
-
-	//post=product/sum(product)
-	sum := 0.0
-	for i, _ := range product {
-		sum += product[i]
-	}
-
-	post := make([]float64, len(product))
-	for i, _ := range product {
-		post[i] = product[i] / sum
+	// post is proportional to like*prior
+	logw := make([]float64, len(p))
+	for i, _ := range logw {
+		logw[i] = like[i] + log(prior[i])
 	}
-	return post
+	return NormalizeLogWeights(logw)
 }
@@ -37,15 +37,39 @@ func PoissonLambdaPDFJPri(sumK, n int64) func(p float64) float64 {
 // Use r=m^2/s^2, and v=m/s^2, if you summarize your prior belief with mean == m, and std == s.
 func PoissonLambdaPDFGPri(sumK, n int64, r, v float64) func(p float64) float64 {
 	// CAUTION !!! v= 1/scale !!!
+	if sumK < 0 || n <= 0 {
+		panic("bad data")
+	}
+	return GammaPrior{r, v}.Update(sumK, n).PDF()
+}
+
+// Poisson λ, posterior, gamma prior, as a GammaDist with shape r+sumK and rate v+n.
+// It can be fed back as the prior for the next batch of data.
+func PoissonLambdaPostGPri(sumK, n int64, r, v float64) GammaDist {
 	if sumK < 0 || n <= 0 {
 		panic("bad data")
 	}
 	if r < 0 || v < 0 {
 		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
 	}
-	r1 := r + float64(sumK)
-	v1 := v + float64(n)
-	return GammaPDF(r1, 1/v1)
+	return GammaDist{Shape: r + float64(sumK), Rate: v + float64(n)}
+}
+
+// PoissonLambdaUpdate returns the shape and rate of the gamma posterior of Poisson rate λ after newSumK events
+// in newN further intervals, given the current gamma(rPri, vPri) prior, which may itself be a posterior.
+// Updating interval by interval gives the same result as one update with all the data.
+// v is a RATE (1/scale), as everywhere in this file.
+// Bolstad 2007 (2e): Chapter 10.
+func PoissonLambdaUpdate(rPri, vPri float64, newSumK, newN int64) (rPost, vPost float64) {
+	// rPri, vPri		shape and rate of the current gamma distribution of λ
+	// newSumK, newN	total new events in newN equal time intervals
+	if rPri < 0 || vPri < 0 {
+		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
+	}
+	if newSumK < 0 || newN < 0 || (newN == 0 && newSumK > 0) {
+		panic("bad data")
+	}
+	return rPri + float64(newSumK), vPri + float64(newN)
 }
 
 // Poisson λ, posterior CDF, flat prior.
@@ -77,12 +101,7 @@ func PoissonLambdaCDFGPri(sumK, n int64, r, v float64) func(p float64) float64 {
 	if sumK < 0 || n <= 0 {
 		panic("bad data")
 	}
-	if r < 0 || v < 0 {
-		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
-	}
-	r1 := r + float64(sumK)
-	v1 := v + float64(n)
-	return GammaCDF(r1, 1/v1)
+	return GammaPrior{r, v}.Update(sumK, n).CDF()
 }
 
 // Poisson λ, posterior quantile function, flat prior.
@@ -114,12 +133,7 @@ func PoissonLambdaQtlGPri(sumK, n int64, r, v float64) func(p float64) float64 {
 	if sumK < 0 || n <= 0 {
 		panic("bad data")
 	}
-	if r < 0 || v < 0 {
-		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
-	}
-	r1 := r + float64(sumK)
-	v1 := v + float64(n)
-	return GammaQtl(r1, 1/v1)
+	return GammaPrior{r, v}.Update(sumK, n).Qtl()
 }
 
 // PoissonLambdaNextFPri returns random number drawn from the posterior, flat prior.
@@ -155,11 +169,32 @@ func PoissonLambdaNextGPri(sumK, n int64, r, v float64) float64 {
 	return GammaNext(r1, 1/v1)
 }
 
-// Likelihood of Poisson λ.
+// PoissonLambdaNextGPriSampler returns random number drawn from the posterior, Gamma prior, using smp.
+// A seeded Sampler makes the draws reproducible.
+func PoissonLambdaNextGPriSampler(sumK, n int64, r, v float64, smp *Sampler) float64 {
+	post := PoissonLambdaPostGPri(sumK, n, r, v)
+	return smp.GammaNext(post.Shape, 1/post.Rate)
+}
+
+// Likelihood of Poisson λ, given sumK events in n intervals: λ^sumK * exp(-n*λ).
+// The constant 1/(k1! k2! ... kn!) is left out, as it does not depend on λ and is not known from sumK alone;
+// the likelihood is therefore only defined up to a constant factor. It has its maximum at λ = sumK/n.
 // Bolstad 2007 (2e): Chapter 10, p. 184.
 func PoissonLambdaLike(sumK, n int64, λ float64) float64 {
-	return λ * float64(sumK) * math.Exp(float64(-n)*λ)
-
+	if sumK < 0 || n <= 0 {
+		panic("bad data")
+	}
+	if λ < 0 {
+		return 0
+	}
+	if λ == 0 { // avoid 0 * log(0)
+		if sumK == 0 {
+			return 1
+		}
+		return 0
+	}
+	// in logs, so that λ^sumK does not overflow before it is multiplied by exp(-n*λ)
+	return math.Exp(float64(sumK)*math.Log(λ) - float64(n)*λ)
 }
 
 // Equivalent sample size of the prior 
@@ -168,12 +203,14 @@ func PoissonLambdaEqvSize(v float64) float64 {
 	return (math.Floor(v))
 }
 
-// Posterior mean 
+// Posterior mean of λ, gamma prior with shape r and rate v: the posterior is Gamma(r+sumK, 1/(v+n)).
+// For the flat prior use r = 1, v = 0; for the Jeffreys prior r = 0.5, v = 0.
 // Bolstad 2007 (2e): Chapter 10, p. 190-191.
 func PoissonLambdaPostMean(sumK, n int64, r, v float64) float64 {
-	r1 := float64(sumK) + 1.0
-	v1 := 1.0
-	return r1 / v1
+	if sumK < 0 || n <= 0 {
+		panic("bad data")
+	}
+	return GammaPrior{r, v}.Update(sumK, n).Mean()
 }
 
 // Posterior mean bias
@@ -218,11 +255,25 @@ func PoissonLambdaCrIGPri(sumK, n int64, r, v, α float64) (lo, hi float64) {
 	*/
 	// return value: lo is lower boundary, hi upper
 	qf := PoissonLambdaQtlGPri(sumK, n, r, v)
-	lo = qf(α / 2)
-	hi = qf(1 - α/2)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = qf(αLo)
+	hi = qf(αHi)
 	return
 }
 
+// Highest posterior density interval for unknown Poisson rate λ, and gamma prior.
+// The Gamma posterior is skewed to the right, so the HPD interval is shorter than the equal tail interval
+// of PoissonLambdaCrIGPri and lies to the left of it.
+func PoissonLambdaHPDGPri(sumK, n int64, r, v, α float64) (lo, hi float64) {
+	/*
+		sumK, n			total observed events in n equal time intervals
+		r			gamma prior r
+		v			gamma prior v
+		α		posterior probability that the true rate lies outside the interval
+	*/
+	return HPDInterval(PoissonLambdaPDFGPri(sumK, n, r, v), PoissonLambdaQtlGPri(sumK, n, r, v), α)
+}
+
 // One-sided test for Poisson rate λ
 // Bolstad 2007 (2e): 193.
 // H0: λ <= λ0 vs H1: λ > λ0
@@ -247,6 +298,28 @@ func PoissonLambdaOneSidedOdds(sumK, n int64, r, v, λ0 float64) float64 {
 	return p0 / (1 - p0)
 }
 
+// Bayes factor of the one-sided test for Poisson rate λ
+// H0: λ <= λ0 vs H1: λ > λ0
+// The posterior odds of PoissonLambdaOneSidedOdds divided by the prior odds P(λ <= λ0)/P(λ > λ0) of the gamma(r, v) prior,
+// so it measures the evidence of the data alone; values above 1 favour H0. The prior must be proper, r > 0 and v > 0.
+// With no data (sumK = 0, n = 0) the posterior is the prior, and the Bayes factor is 1.
+func PoissonLambdaBayesFactor(sumK, n int64, r, v, λ0 float64) float64 {
+	// sumK, n	total observed events in n equal time intervals
+	// r, v		shape and rate of the gamma prior
+	// λ0		boundary between the hypotheses
+	if !(r > 0 && v > 0) {
+		panic("the prior odds need a proper prior: r and v must be greater than zero")
+	}
+	if !(λ0 > 0) || isInf(λ0, 1) {
+		panic("λ0 must be greater than zero")
+	}
+	pri := GammaPrior{r, v}
+	post := pri.Update(sumK, n)
+	p0Pri := pri.CDF()(λ0)
+	p0Post := post.CDF()(λ0)
+	return (p0Post / (1 - p0Post)) / (p0Pri / (1 - p0Pri))
+}
+
 // Two-sided test for Poisson rate λ
 // Bolstad 2007 (2e): 194.
 // H0: λ = λ0 vs H1: λ != λ0
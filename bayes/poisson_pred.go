@@ -0,0 +1,147 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Predictive distribution of future Poisson counts, gamma prior.
+// With posterior Gamma(r1, v1) for λ, the number of events in the next h intervals is Negative binomial
+// with size r1 and probability v1/(v1+h), the Pólya distribution of dst with size r1 and success probability h/(v1+h).
+// Ref.: Gelman et al. 2004 (2e): 52-53.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+)
+
+// poissonPredPMF returns the PMF of the Negative binomial predictive distribution with real size r1, rate v1, over h intervals.
+func poissonPredPMF(r1, v1, h float64) func(k int64) float64 {
+	return PolyaPMF(h/(v1+h), r1)
+}
+
+// poissonPredCDF returns the CDF of the Negative binomial predictive distribution with real size r1, rate v1, over h intervals.
+func poissonPredCDF(r1, v1, h float64) func(k int64) float64 {
+	ρ := v1 / (v1 + h)
+	return func(k int64) float64 {
+		if k < 0 {
+			return 0
+		}
+		return BetaCDFAt(r1, float64(k)+1, ρ)
+	}
+}
+
+// poissonPredQtl returns the quantile function of the Negative binomial predictive distribution, smallest k with CDF(k) >= p.
+func poissonPredQtl(r1, v1, h float64) func(p float64) int64 {
+	cdf := poissonPredCDF(r1, v1, h)
+	return func(p float64) int64 {
+		// bracket
+		lo, hi := int64(-1), int64(floor(h*r1/v1))+1
+		for cdf(hi) < p {
+			lo = hi
+			hi *= 2
+		}
+		// bisection on integers: cdf(lo) < p <= cdf(hi)
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if cdf(mid) < p {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		return hi
+	}
+}
+
+// PoissonForecast returns the expected number of events in the next horizon intervals, and the (1-α) equal-tail prediction interval,
+// for Poisson rate λ with gamma prior.
+// Use r=m^2/s^2, and v=m/s^2, if you summarize your prior belief with mean == m, and std == s.
+func PoissonForecast(sumK, n int64, r, v float64, horizon int64, α float64) (expected float64, lo, hi int64) {
+	// Arguments:
+	// sumK, n	total observed events in n equal time intervals
+	// r, v		shape and rate of the gamma prior
+	// horizon	number of future intervals
+	// α		probability that the future count lies outside the prediction interval
+	if sumK < 0 || n <= 0 {
+		panic("bad data")
+	}
+	if r < 0 || v < 0 {
+		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
+	}
+	if horizon <= 0 {
+		panic(fmt.Sprintf("horizon must be greater than zero"))
+	}
+	αLo, αHi := TailsFromConfidence(1 - α)
+	r1 := r + float64(sumK)
+	v1 := v + float64(n)
+	h := float64(horizon)
+	expected = h * r1 / v1
+	qtl := poissonPredQtl(r1, v1, h)
+	lo = qtl(αLo)
+	hi = qtl(αHi)
+	return
+}
+
+// poissonPredPost checks the data and the gamma prior, and returns the shape and rate of the posterior of λ.
+func poissonPredPost(sumK, n int64, r, v float64) (r1, v1 float64) {
+	if sumK < 0 || n <= 0 {
+		panic("bad data")
+	}
+	if r < 0 || v < 0 {
+		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
+	}
+	r1 = r + float64(sumK)
+	if r1 <= 0 {
+		panic(fmt.Sprintf("posterior is improper: r must be greater than zero when no events were observed"))
+	}
+	return r1, v + float64(n)
+}
+
+// PoissonPredPMFGPri returns the posterior predictive PMF of the count in the next unit interval, gamma prior.
+// It is Negative binomial with size r+sumK and probability (v+n)/(v+n+1), not Poisson; its mean is the posterior mean
+// of λ, (r+sumK)/(v+n), and its variance is larger than that mean by the posterior variance of λ.
+func PoissonPredPMFGPri(sumK, n int64, r, v float64) func(k int64) float64 {
+	// sumK, n	total observed events in n equal time intervals
+	// r, v		shape and rate of the gamma prior
+	r1, v1 := poissonPredPost(sumK, n, r, v)
+	return poissonPredPMF(r1, v1, 1)
+}
+
+// PoissonPredCDFGPri returns the posterior predictive CDF of the count in the next unit interval, gamma prior.
+func PoissonPredCDFGPri(sumK, n int64, r, v float64) func(k int64) float64 {
+	r1, v1 := poissonPredPost(sumK, n, r, v)
+	return poissonPredCDF(r1, v1, 1)
+}
+
+// PoissonPredSampleGPri returns draws of the count in the next unit interval from its posterior predictive
+// distribution, gamma prior. If rng is nil, a freshly seeded source is used.
+func PoissonPredSampleGPri(sumK, n int64, r, v float64, draws int, rng *rand.Rand) []int64 {
+	// draws	number of simulated counts
+	// rng		source of randomness
+	r1, v1 := poissonPredPost(sumK, n, r, v)
+	smp := newSampler(rng)
+	k := make([]int64, draws)
+	for i := range k {
+		k[i] = smp.PolyaNext(1/(v1+1), r1)
+	}
+	return k
+}
+
+// PoissonLambdaPredPMFFPri returns the posterior predictive PMF of the count in the next interval, flat prior.
+func PoissonLambdaPredPMFFPri(sumK, n int64) func(k int64) float64 {
+	return PoissonPredPMFGPri(sumK, n, 1, 0)
+}
+
+// PoissonLambdaPredCDFFPri returns the posterior predictive CDF of the count in the next interval, flat prior.
+func PoissonLambdaPredCDFFPri(sumK, n int64) func(k int64) float64 {
+	return PoissonPredCDFGPri(sumK, n, 1, 0)
+}
+
+// PoissonLambdaPredPMFJPri returns the posterior predictive PMF of the count in the next interval, Jeffreys prior.
+func PoissonLambdaPredPMFJPri(sumK, n int64) func(k int64) float64 {
+	return PoissonPredPMFGPri(sumK, n, 0.5, 0)
+}
+
+// PoissonLambdaPredCDFJPri returns the posterior predictive CDF of the count in the next interval, Jeffreys prior.
+func PoissonLambdaPredCDFJPri(sumK, n int64) func(k int64) float64 {
+	return PoissonPredCDFGPri(sumK, n, 0.5, 0)
+}
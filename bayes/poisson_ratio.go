@@ -0,0 +1,68 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Posterior of the log rate ratio log(λ1/λ2) of two Poisson processes, each with its own Gamma(r, v) prior.
+// On the log scale the posterior is much closer to symmetric than on the ratio scale, so the Normal
+// approximation is useful, and an interval for log(λ1/λ2) exponentiates to an interval for λ1/λ2.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+)
+
+// poissonLogRatioShapes returns the posterior shapes and rates of both rates, checking the arguments.
+func poissonLogRatioShapes(sumK1, n1, sumK2, n2 int64, r1, v1, r2, v2 float64) (a1, b1, a2, b2 float64) {
+	if r1 < 0 || v1 < 0 || r2 < 0 || v2 < 0 {
+		panic(fmt.Sprintf("Shape parameter r and rate parameter v must be greater than or equal to zero"))
+	}
+	if sumK1 < 0 || sumK2 < 0 || n1 <= 0 || n2 <= 0 {
+		panic("bad data")
+	}
+	a1, b1 = r1+float64(sumK1), v1+float64(n1)
+	a2, b2 = r2+float64(sumK2), v2+float64(n2)
+	if a1 <= 0 || a2 <= 0 {
+		panic(fmt.Sprintf("posterior is improper: r must be greater than zero when no events were observed"))
+	}
+	return
+}
+
+// PoissonLogRatioPostSample returns draws from the posterior of log(λ1/λ2).
+// If rng is nil, a freshly seeded source is used.
+func PoissonLogRatioPostSample(sumK1, n1, sumK2, n2 int64, r1, v1, r2, v2 float64, draws int, rng *rand.Rand) []float64 {
+	// Arguments:
+	// sumK1, n1	total observed events in n1 intervals, first group
+	// sumK2, n2	total observed events in n2 intervals, second group
+	// r1, v1	shape and rate of the Gamma prior of λ1
+	// r2, v2	shape and rate of the Gamma prior of λ2
+	// draws	number of simulated log ratios
+	// rng		source of randomness
+	a1, b1, a2, b2 := poissonLogRatioShapes(sumK1, n1, sumK2, n2, r1, v1, r2, v2)
+	smp := newSampler(rng)
+	lr := make([]float64, draws)
+	for i := range lr {
+		lr[i] = log(smp.GammaNext(a1, 1/b1)) - log(smp.GammaNext(a2, 1/b2))
+	}
+	return lr
+}
+
+// PoissonLogRatioPost returns the mean and standard deviation of the Normal approximation to the posterior
+// of log(λ1/λ2), by the delta method: log λ is approximately Normal(log(a/b), 1/a) for λ ~ Gamma(a, 1/b).
+func PoissonLogRatioPost(sumK1, n1, sumK2, n2 int64, r1, v1, r2, v2 float64) (μ, σ float64) {
+	a1, b1, a2, b2 := poissonLogRatioShapes(sumK1, n1, sumK2, n2, r1, v1, r2, v2)
+	μ = log(a1/b1) - log(a2/b2)
+	σ = sqrt(1/a1 + 1/a2)
+	return
+}
+
+// PoissonLogRatioCrI returns the equal tail area credible interval for log(λ1/λ2), Normal approximation.
+// exp(lo), exp(hi) is the credible interval for the rate ratio λ1/λ2.
+func PoissonLogRatioCrI(sumK1, n1, sumK2, n2 int64, r1, v1, r2, v2, α float64) (lo, hi float64) {
+	// α		posterior probability that the true log ratio lies outside the credible interval
+	μ, σ := PoissonLogRatioPost(sumK1, n1, sumK2, n2, r1, v1, r2, v2)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = μ + ZQtlFor(αLo)*σ
+	hi = μ + ZQtlFor(αHi)*σ
+	return
+}
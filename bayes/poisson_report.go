@@ -0,0 +1,73 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Everything about a Poisson rate λ in one call: posterior summaries, credible intervals,
+// tests against a given λ0, and the forecast of future counts, for a gamma prior.
+// Bolstad 2007 (2e): Chapter 10.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+)
+
+// PoissonLambdaReport holds the results of PoissonReport.
+type PoissonLambdaReport struct {
+	Post               GammaPrior // posterior of λ
+	Mean, Median, Mode float64    // Mode is NaN if the posterior shape is at most 1
+	SD                 float64
+	CrILo, CrIHi       float64 // equal tail credible interval
+	HPDLo, HPDHi       float64 // highest posterior density interval
+	Lambda0            float64 // λ0 of the tests
+	OneSidedReject     bool    // H0: λ <= λ0 rejected in favour of λ > λ0
+	OneSidedOdds       float64 // posterior odds of H0: λ <= λ0
+	TwoSidedReject     bool    // H0: λ = λ0 rejected, λ0 outside the equal tail credible interval
+	Horizon            int64
+	Expected           float64 // expected number of events in the next Horizon intervals
+	PredLo, PredHi     int64   // equal tail prediction interval of that number
+}
+
+// PoissonReport returns the posterior summaries, the (1-α) credible intervals, the tests of λ against λ0 at level α,
+// and the (1-α) forecast for the next horizon intervals, after sumK events in n intervals and the gamma prior.
+// Each field is what the dedicated function returns, e.g. CrILo, CrIHi of PoissonLambdaCrIGPri.
+func PoissonReport(sumK, n int64, prior GammaPrior, α, λ0 float64, horizon int64) PoissonLambdaReport {
+	// Arguments:
+	// sumK, n	total observed events in n equal time intervals
+	// prior	gamma prior of λ
+	// α		probability outside the intervals, and level of the tests
+	// λ0		rate the tests compare λ with
+	// horizon	number of future intervals to forecast
+	if sumK < 0 || n <= 0 {
+		panic("bad data")
+	}
+	prior.check()
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	if !(λ0 > 0) || isInf(λ0, 1) {
+		panic(fmt.Sprintf("λ0 must be greater than zero"))
+	}
+	if horizon <= 0 {
+		panic(fmt.Sprintf("horizon must be greater than zero"))
+	}
+	r, v := prior.R, prior.V
+	var rep PoissonLambdaReport
+	rep.Post = prior.Update(sumK, n)
+	if rep.Post.R <= 0 {
+		panic(fmt.Sprintf("posterior is improper: r must be greater than zero when no events were observed"))
+	}
+	θ := 1 / rep.Post.V
+	rep.Mean = rep.Post.Mean()
+	rep.Median = rep.Post.Qtl()(0.5)
+	rep.Mode = GammaMode(rep.Post.R, θ)
+	rep.SD = GammaStd(rep.Post.R, θ)
+	rep.CrILo, rep.CrIHi = PoissonLambdaCrIGPri(sumK, n, r, v, α)
+	rep.HPDLo, rep.HPDHi = PoissonLambdaHPDGPri(sumK, n, r, v, α)
+	rep.Lambda0 = λ0
+	rep.OneSidedReject = PoissonLambdaOneSidedTst(sumK, n, r, v, α, λ0)
+	rep.OneSidedOdds = PoissonLambdaOneSidedOdds(sumK, n, r, v, λ0)
+	rep.TwoSidedReject = PoissonLambdaTwoSidedTst(sumK, n, r, v, α, λ0)
+	rep.Horizon = horizon
+	rep.Expected, rep.PredLo, rep.PredHi = PoissonForecast(sumK, n, r, v, horizon, α)
+	return rep
+}
@@ -0,0 +1,54 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Monte Carlo samples of functions of two independent posteriors, for which no closed form is at hand.
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// PoissonRatioPostSample returns draws from the posterior of the ratio λ1/λ2 of two Poisson rates,
+// each with its own Gamma(r, v) prior. If rng is nil, a freshly seeded source is used.
+func PoissonRatioPostSample(sumK1, n1, sumK2, n2 int64, r, v float64, draws int, rng *rand.Rand) []float64 {
+	// Arguments:
+	// sumK1, n1	total observed events in n1 intervals, first group
+	// sumK2, n2	total observed events in n2 intervals, second group
+	// r, v		shape and rate of the Gamma prior
+	// draws	number of simulated ratios
+	// rng		source of randomness
+	if r < 0 || v < 0 {
+		panic(fmt.Sprintf("Shape parameter r and rate parameter v must be greater than or equal to zero"))
+	}
+	if sumK1 < 0 || sumK2 < 0 || n1 <= 0 || n2 <= 0 {
+		panic("bad data")
+	}
+	smp := newSampler(rng)
+	r1, v1 := r+float64(sumK1), v+float64(n1)
+	r2, v2 := r+float64(sumK2), v+float64(n2)
+	ratio := make([]float64, draws)
+	for i := range ratio {
+		ratio[i] = smp.GammaNext(r1, 1/v1) / smp.GammaNext(r2, 1/v2)
+	}
+	return ratio
+}
+
+// BinomDiffPostSample returns draws from the posterior of the difference π1-π2 of two Binomial proportions,
+// each with its own Beta(α, β) prior. If rng is nil, a freshly seeded source is used.
+// As for BinomPiDiffNextBPri, a zero prior parameter, e.g. Haldane's α = β = 0, needs a success and a failure in each group.
+func BinomDiffPostSample(k1, n1, k2, n2 int64, α, β float64, draws int, rng *rand.Rand) []float64 {
+	// Arguments:
+	// k1, n1	observed successes in n1 trials, first group
+	// k2, n2	observed successes in n2 trials, second group
+	// α, β		parameters of the Beta prior
+	// draws	number of simulated differences
+	// rng		source of randomness
+	a1, b1, a2, b2 := binomPiDiffPost(k1, n1, k2, n2, α, β, α, β)
+	smp := newSampler(rng)
+	diff := make([]float64, draws)
+	for i := range diff {
+		diff[i] = smp.BetaNext(a1, b1) - smp.BetaNext(a2, b2)
+	}
+	return diff
+}
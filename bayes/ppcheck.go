@@ -0,0 +1,136 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Posterior predictive check of a discrepancy statistic, with a percentile bootstrap interval for its observed value.
+// The predictive distribution shows what the model expects the statistic to be; the bootstrap interval shows
+// how precisely the data pin down the observed value. Little overlap between the two points to misfit.
+// Ref.: Gelman et al. 2004 (2e): Chapter 6.3; Efron and Tibshirani 1993: Chapter 13.
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// PPCheck holds the result of a posterior predictive check.
+type PPCheck struct {
+	Observed       float64   // statistic of the data
+	Predictive     []float64 // statistic of each replicated data set, sorted
+	PValue         float64   // posterior predictive p-value, P(T(rep) >= T(obs))
+	BootLo, BootHi float64   // percentile bootstrap interval of the observed statistic
+	Overlap        float64   // share of the predictive distribution inside the bootstrap interval
+}
+
+// PPCheckBootstrap returns the posterior predictive distribution of the statistic stat, and a (1-α) percentile
+// bootstrap interval for its observed value. replicate must draw the parameters from the posterior and then a data set
+// of the same size as data from the model. If rng is nil, a freshly seeded source is used.
+func PPCheckBootstrap(data []float64, stat func([]float64) float64, replicate func(rng *rand.Rand) []float64, draws, boots int, α float64, rng *rand.Rand) PPCheck {
+	// Arguments:
+	// data		observations
+	// stat		discrepancy statistic
+	// replicate	draws one replicated data set from the posterior predictive distribution
+	// draws	number of replicated data sets
+	// boots	number of bootstrap resamples of data
+	// α		probability outside the bootstrap interval
+	// rng		source of randomness
+	if len(data) == 0 {
+		panic(fmt.Sprintf("empty sample"))
+	}
+	if draws < 1 || boots < 2 {
+		panic(fmt.Sprintf("draws must be at least 1, and boots at least 2"))
+	}
+	αLo, αHi := TailsFromConfidence(1 - α)
+	checkFinite("data", data...)
+	rng = newSampler(rng).Rand()
+
+	var r PPCheck
+	r.Observed = stat(data)
+	r.Predictive = make([]float64, draws)
+	above := 0
+	for i := range r.Predictive {
+		r.Predictive[i] = stat(replicate(rng))
+		if r.Predictive[i] >= r.Observed {
+			above++
+		}
+	}
+	sort.Float64s(r.Predictive)
+	r.PValue = float64(above) / float64(draws)
+
+	boot := make([]float64, boots)
+	resample := make([]float64, len(data))
+	for b := range boot {
+		for i := range resample {
+			resample[i] = data[rng.Intn(len(data))]
+		}
+		boot[b] = stat(resample)
+	}
+	sort.Float64s(boot)
+	r.BootLo = sortedQtl(boot, αLo)
+	r.BootHi = sortedQtl(boot, αHi)
+
+	in := 0
+	for _, t := range r.Predictive {
+		if t >= r.BootLo && t <= r.BootHi {
+			in++
+		}
+	}
+	r.Overlap = float64(in) / float64(draws)
+	return r
+}
+
+// vmr returns the variance to mean ratio of counts, the index of dispersion; 1 if all counts are zero.
+func vmr(counts []float64) float64 {
+	m, sd := meanSd(counts)
+	if m == 0 {
+		return 1
+	}
+	return sd * sd / m
+}
+
+// PoissonOverdispersionPPP returns the posterior predictive p-value P(VMR(rep) >= VMR(obs)) of the variance to mean
+// ratio of counts, under the Poisson model with a gamma(r, v) prior of λ (v is a rate). Each replicate draws λ from
+// the gamma posterior, and then len(counts) Poisson counts. A p-value near 0 points to overdispersion,
+// near 1 to underdispersion. If rng is nil, a freshly seeded source is used.
+// Ref.: Gelman et al. 2004 (2e): Chapter 6.3.
+func PoissonOverdispersionPPP(counts []int64, r, v float64, nRep int, rng *rand.Rand) float64 {
+	// Arguments:
+	// counts	observed counts, at least 2
+	// r, v		shape and rate of the gamma prior
+	// nRep		number of replicated data sets
+	// rng		source of randomness
+	if len(counts) < 2 {
+		panic(fmt.Sprintf("at least 2 counts are needed"))
+	}
+	if nRep < 1 {
+		panic(fmt.Sprintf("nRep must be at least 1"))
+	}
+	data := make([]float64, len(counts))
+	sumK := iZero
+	for i, k := range counts {
+		if k < 0 {
+			panic("bad data")
+		}
+		data[i] = float64(k)
+		sumK += k
+	}
+	rPost, vPost := PoissonLambdaUpdate(r, v, sumK, int64(len(counts)))
+	if rPost <= 0 {
+		panic(fmt.Sprintf("posterior is improper: r must be greater than zero when no events were observed"))
+	}
+	smp := newSampler(rng)
+
+	obs := vmr(data)
+	rep := make([]float64, len(counts))
+	above := 0
+	for j := 0; j < nRep; j++ {
+		λ := smp.GammaNext(rPost, 1/vPost)
+		for i := range rep {
+			rep[i] = float64(smp.PoissonNext(λ))
+		}
+		if vmr(rep) >= obs {
+			above++
+		}
+	}
+	return float64(above) / float64(nRep)
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Priors given as strings, for command line flags and configuration files.
+// A prior is either the name of a reference prior, "flat" or "Jeffreys", or a proper distribution of the
+// conjugate family in a form read by dst.ParseDistribution, e.g. "Gamma(shape=2, rate=1)" or "Beta(2, 5)".
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"strings"
+)
+
+// ParseGammaPrior returns the gamma prior of a Poisson rate λ written as s: "flat" (r = 1, v = 0),
+// "Jeffreys" (r = 0.5, v = 0), or a Gamma distribution, e.g. "Gamma(shape=2, rate=1)".
+func ParseGammaPrior(s string) (GammaPrior, error) {
+	switch {
+	case strings.EqualFold(strings.TrimSpace(s), "flat"):
+		return GammaPrior{1, 0}, nil
+	case strings.EqualFold(strings.TrimSpace(s), "Jeffreys"):
+		return GammaPrior{0.5, 0}, nil
+	}
+	d, err := ParseDistribution(s)
+	if err != nil {
+		return GammaPrior{}, err
+	}
+	g, ok := d.(GammaDist)
+	if !ok {
+		return GammaPrior{}, fmt.Errorf("bayes: the prior of a rate must be flat, Jeffreys or Gamma, not %v", d)
+	}
+	return GammaPrior{g.Shape, g.Rate}, nil
+}
+
+// ParseBetaPrior returns the beta prior of a binomial proportion π written as s: "flat" (α = β = 1),
+// "Jeffreys" (α = β = 0.5), or a Beta distribution, e.g. "Beta(α=2, β=5)".
+func ParseBetaPrior(s string) (BetaDist, error) {
+	switch {
+	case strings.EqualFold(strings.TrimSpace(s), "flat"):
+		return BetaDist{A: 1, B: 1}, nil
+	case strings.EqualFold(strings.TrimSpace(s), "Jeffreys"):
+		return BetaDist{A: 0.5, B: 0.5}, nil
+	}
+	d, err := ParseDistribution(s)
+	if err != nil {
+		return BetaDist{}, err
+	}
+	b, ok := d.(BetaDist)
+	if !ok {
+		return BetaDist{}, fmt.Errorf("bayes: the prior of a proportion must be flat, Jeffreys or Beta, not %v", d)
+	}
+	return b, nil
+}
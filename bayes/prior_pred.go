@@ -0,0 +1,60 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Prior predictive simulation, to check whether the prior implies reasonable data before any data are seen.
+// Ref.: Gelman et al. 2004 (2e): Chapter 6.
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// PoissonPriorPredictiveSample returns counts simulated from the prior predictive distribution of the Poisson model
+// with Gamma(r, v) prior: λ is drawn from the prior, then the total count over n intervals is drawn from Poisson(n*λ).
+// If rng is nil, a freshly seeded source is used.
+func PoissonPriorPredictiveSample(r, v float64, n int64, draws int, rng *rand.Rand) []int64 {
+	// Arguments:
+	// r, v		shape and rate of the Gamma prior
+	// n		number of intervals
+	// draws	number of simulated counts
+	// rng		source of randomness
+	if r <= 0 || v <= 0 {
+		panic(fmt.Sprintf("Shape parameter r and rate parameter v must be greater than zero"))
+	}
+	if n <= 0 {
+		panic(fmt.Sprintf("number of intervals n must be greater than zero"))
+	}
+	smp := newSampler(rng)
+	k := make([]int64, draws)
+	for i := range k {
+		λ := smp.GammaNext(r, 1/v)
+		k[i] = smp.PoissonNext(float64(n) * λ)
+	}
+	return k
+}
+
+// NormMuPriorPredictiveSample returns sample means simulated from the prior predictive distribution of the Normal model
+// with KNOWN σ and Normal(μPri, σPri) prior: μ is drawn from the prior, then the mean of nObs observations is drawn from Normal(μ, σ/√nObs).
+// If rng is nil, a freshly seeded source is used.
+func NormMuPriorPredictiveSample(μPri, σPri, σ float64, nObs int, draws int, rng *rand.Rand) []float64 {
+	// Arguments:
+	// μPri, σPri	Normal prior mean and standard deviation
+	// σ		standard deviation of population, assumed to be known
+	// nObs		number of observations in each simulated sample
+	// draws	number of simulated sample means
+	// rng		source of randomness
+	checkNormσ(σ)
+	checkNormσPri(σPri)
+	if nObs <= 0 {
+		panic(fmt.Sprintf("number of observations nObs must be greater than zero"))
+	}
+	smp := newSampler(rng)
+	σȳ := σ / sqrt(float64(nObs))
+	ȳ := make([]float64, draws)
+	for i := range ȳ {
+		μ := smp.NormalNext(μPri, σPri)
+		ȳ[i] = smp.NormalNext(μ, σȳ)
+	}
+	return ȳ
+}
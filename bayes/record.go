@@ -0,0 +1,71 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Audit records of inference runs, for documenting an analysis.
+// Record calls a credible interval entry point and returns its inputs and outputs as an InferenceRecord,
+// which marshals to JSON and can be re-run with Replay. The entry points themselves have no side effects.
+
+import (
+	"fmt"
+)
+
+// Version is the library version written to each InferenceRecord.
+const Version = "0.1"
+
+// InferenceRecord holds the inputs and outputs of one call of an inference function.
+// Non-finite values (NaN, ±Inf) cannot be marshalled to JSON.
+type InferenceRecord struct {
+	Function string             `json:"function"`
+	Version  string             `json:"version"`
+	Data     map[string]float64 `json:"data"`
+	Prior    map[string]float64 `json:"prior,omitempty"`
+	Alpha    float64            `json:"alpha"`
+	Output   map[string]float64 `json:"output"`
+}
+
+// Record calls function with the data, the prior and α, and returns the record of the call. data and prior hold
+// the arguments under their parameter names, e.g. "sumK", "n" and "r", "v" for PoissonLambdaCrIGPri.
+func Record(function string, α float64, data, prior map[string]float64) InferenceRecord {
+	rec := InferenceRecord{Function: function, Version: Version, Data: data, Prior: prior, Alpha: α}
+	rec.Output = Replay(rec)
+	return rec
+}
+
+// replayers run the recorded functions from the inputs of a record.
+var replayers = map[string]func(rec InferenceRecord) map[string]float64{
+	"PoissonLambdaCrIGPri": func(rec InferenceRecord) map[string]float64 {
+		lo, hi := PoissonLambdaCrIGPri(int64(rec.Data["sumK"]), int64(rec.Data["n"]), rec.Prior["r"], rec.Prior["v"], rec.Alpha)
+		return map[string]float64{"lo": lo, "hi": hi}
+	},
+	"BinomPiCrIBP": func(rec InferenceRecord) map[string]float64 {
+		lo, hi := BinomPiCrIBP(rec.Prior["α"], rec.Prior["β"], rec.Alpha, int64(rec.Data["n"]), int64(rec.Data["k"]))
+		return map[string]float64{"lo": lo, "hi": hi}
+	},
+	"NormMuCrINPriKnown": func(rec InferenceRecord) map[string]float64 {
+		lo, hi := NormMuCrINPriKnown(int(rec.Data["nObs"]), rec.Data["ȳ"], rec.Data["σ"], rec.Prior["μPri"], rec.Prior["σPri"], rec.Alpha)
+		return map[string]float64{"lo": lo, "hi": hi}
+	},
+	"NormMuCrIFPriKnown": func(rec InferenceRecord) map[string]float64 {
+		lo, hi := NormMuCrIFPriKnown(int(rec.Data["nObs"]), rec.Data["ȳ"], rec.Data["σ"], rec.Alpha)
+		return map[string]float64{"lo": lo, "hi": hi}
+	},
+	"NormSigmaSqCrIJPri": func(rec InferenceRecord) map[string]float64 {
+		lo, hi := NormSigmaSqCrIJPri(int(rec.Data["nObs"]), rec.Data["sampleVar"], rec.Alpha)
+		return map[string]float64{"lo": lo, "hi": hi}
+	},
+	"NormalMuDiffCrIJPriUn": func(rec InferenceRecord) map[string]float64 {
+		d := rec.Data
+		lo, hi := NormalMuDiffCrIJPriUn(int(d["nObs1"]), int(d["nObs2"]), d["ȳ1"], d["ȳ2"], d["s1"], d["s2"], rec.Alpha)
+		return map[string]float64{"lo": lo, "hi": hi}
+	},
+}
+
+// Replay re-runs the function of the record with its recorded inputs, and returns the outputs.
+func Replay(rec InferenceRecord) map[string]float64 {
+	f, ok := replayers[rec.Function]
+	if !ok {
+		panic(fmt.Sprintf("cannot replay function %q", rec.Function))
+	}
+	return f(rec)
+}
@@ -0,0 +1,145 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Bayesian simple linear regression with KNOWN σ and independent Normal priors on the coefficients.
+// Bolstad 2007 (2e): Chapter 14.
+//
+// The model is y = αx̄ + β(x - x̄) + e, e ~ N(0, σ²), with the intercept αx̄ taken at the mean x̄ of the x values,
+// not at x = 0. In this parametrization the likelihoods of the slope β and of αx̄ factor, so their posteriors
+// are independent Normals: the least squares slope B = SSxy/SSx has variance σ²/SSx, and ȳ has variance σ²/n.
+// The intercept at x = 0 is αx̄ - βx̄.
+
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math"
+)
+
+// ssx checks the x values, and returns their mean and sum of squares about it.
+func ssx(x []float64) (xBar, ss float64) {
+	n := len(x)
+	if n < 2 {
+		panic(fmt.Sprintf("at least two x values needed"))
+	}
+	checkFinite("x", x...)
+	for _, v := range x {
+		xBar += v
+	}
+	xBar /= float64(n)
+	for _, v := range x {
+		ss += (v - xBar) * (v - xBar)
+	}
+	if ss == 0 {
+		panic(fmt.Sprintf("x values must not all be equal"))
+	}
+	return
+}
+
+// regLS checks the data, and returns the means, the least squares slope and the sum of squares of x about its mean.
+func regLS(x, y []float64) (xBar, yBar, b, ss float64) {
+	if len(y) != len(x) {
+		panic(fmt.Sprintf("x and y must be of equal length"))
+	}
+	xBar, ss = ssx(x)
+	checkFinite("y", y...)
+	for _, v := range y {
+		yBar += v
+	}
+	yBar /= float64(len(y))
+	ssxy := 0.0
+	for i := range x {
+		ssxy += (x[i] - xBar) * (y[i] - yBar)
+	}
+	return xBar, yBar, ssxy / ss, ss
+}
+
+// normUpdate returns the mean and standard deviation of the posterior of a Normal mean with prior N(mPri, sPri²),
+// given an estimate est with standard error se.
+func normUpdate(est, se, mPri, sPri float64) (m, s float64) {
+	checkNormσPri(sPri)
+	wPri := 1 / (sPri * sPri)
+	wLik := 1 / (se * se)
+	m = (wPri*mPri + wLik*est) / (wPri + wLik)
+	s = math.Sqrt(1 / (wPri + wLik))
+	return
+}
+
+// RegSlopePostMean returns the posterior mean of the slope β, Normal prior.
+func RegSlopePostMean(x, y []float64, σ, mβPri, sβPri float64) float64 {
+	// x, y		observations
+	// σ		standard deviation of the errors, assumed to be known
+	// mβPri		prior mean of the slope
+	// sβPri		prior standard deviation of the slope
+	checkNormσ(σ)
+	_, _, b, ss := regLS(x, y)
+	m, _ := normUpdate(b, σ/math.Sqrt(ss), mβPri, sβPri)
+	return m
+}
+
+// RegSlopePostStd returns the posterior standard deviation of the slope β, Normal prior.
+// It does not depend on y.
+func RegSlopePostStd(x []float64, σ, sβPri float64) float64 {
+	checkNormσ(σ)
+	_, ss := ssx(x)
+	_, s := normUpdate(0, σ/math.Sqrt(ss), 0, sβPri)
+	return s
+}
+
+// RegInterceptPostMean returns the posterior mean of the intercept αx̄ at the mean of the x values, Normal prior.
+func RegInterceptPostMean(x, y []float64, σ, mαPri, sαPri float64) float64 {
+	// mαPri		prior mean of αx̄
+	// sαPri		prior standard deviation of αx̄
+	checkNormσ(σ)
+	_, yBar, _, _ := regLS(x, y)
+	m, _ := normUpdate(yBar, σ/math.Sqrt(float64(len(y))), mαPri, sαPri)
+	return m
+}
+
+// RegInterceptPostStd returns the posterior standard deviation of the intercept αx̄ at the mean of the x values, Normal prior.
+func RegInterceptPostStd(nObs int, σ, sαPri float64) float64 {
+	if nObs < 1 {
+		panic(fmt.Sprintf("nObs must be at least 1"))
+	}
+	checkNormσ(σ)
+	_, s := normUpdate(0, σ/math.Sqrt(float64(nObs)), 0, sαPri)
+	return s
+}
+
+// RegSlopeCrI returns the equal tail area credible interval for the slope β, Normal prior.
+func RegSlopeCrI(x, y []float64, σ, mβPri, sβPri, α float64) (lo, hi float64) {
+	// α		posterior probability that the true slope lies outside the credible interval
+	m := RegSlopePostMean(x, y, σ, mβPri, sβPri)
+	s := RegSlopePostStd(x, σ, sβPri)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = m + ZQtlFor(αLo)*s
+	hi = m + ZQtlFor(αHi)*s
+	return
+}
+
+// RegPred returns the mean and standard deviation of the Normal predictive distribution of a new observation at xNew.
+// The variance adds the posterior uncertainty of the line at xNew to the error variance σ².
+func RegPred(x, y []float64, σ, mαPri, sαPri, mβPri, sβPri, xNew float64) (μ, sd float64) {
+	// mαPri, sαPri	prior mean and standard deviation of the intercept αx̄
+	// mβPri, sβPri	prior mean and standard deviation of the slope β
+	// xNew		value of x at which to predict
+	checkFinite("xNew", xNew)
+	xBar, _, _, _ := regLS(x, y)
+	mα := RegInterceptPostMean(x, y, σ, mαPri, sαPri)
+	sα := RegInterceptPostStd(len(x), σ, sαPri)
+	mβ := RegSlopePostMean(x, y, σ, mβPri, sβPri)
+	sβ := RegSlopePostStd(x, σ, sβPri)
+	d := xNew - xBar
+	μ = mα + mβ*d
+	sd = math.Sqrt(sα*sα + d*d*sβ*sβ + σ*σ)
+	return
+}
+
+// RegPredCrI returns the equal tail area prediction interval for a new observation at xNew.
+func RegPredCrI(x, y []float64, σ, mαPri, sαPri, mβPri, sβPri, xNew, α float64) (lo, hi float64) {
+	μ, sd := RegPred(x, y, σ, mαPri, sαPri, mβPri, sβPri, xNew)
+	αLo, αHi := TailsFromConfidence(1 - α)
+	lo = μ + ZQtlFor(αLo)*sd
+	hi = μ + ZQtlFor(αHi)*sd
+	return
+}
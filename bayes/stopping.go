@@ -0,0 +1,49 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Operating characteristics of sequential stopping rules for Poisson counts, by simulation.
+// Shows how peeking at the data after every observation affects the error rate of a test.
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// SimulateStoppingRule repeatedly draws Poisson(trueRate) counts one at a time, applies the stopping rule after each one,
+// and returns the average number of observations at stopping, and the fraction of runs that stopped before maxN.
+// When the rule stops on rejecting H0: λ = trueRate, the fraction of stopped runs is the false positive rate.
+// If rng is nil, a freshly seeded source is used.
+func SimulateStoppingRule(trueRate float64, rule func(counts []int64) bool, maxN int, reps int, rng *rand.Rand) (avgStoppingN float64, falsePositiveRate float64) {
+	// Arguments:
+	// trueRate	Poisson rate λ the counts are drawn from
+	// rule		stopping rule, called with the counts observed so far; true means stop (reject)
+	// maxN		maximum number of observations in a run
+	// reps		number of simulated runs
+	// rng		source of randomness
+	if trueRate <= 0 {
+		panic(fmt.Sprintf("trueRate must be greater than zero"))
+	}
+	if maxN <= 0 || reps <= 0 {
+		panic(fmt.Sprintf("maxN and reps must be greater than zero"))
+	}
+	smp := newSampler(rng)
+	counts := make([]int64, maxN)
+	stopped := 0
+	total := 0
+	for i := 0; i < reps; i++ {
+		n := maxN
+		for j := 0; j < maxN; j++ {
+			counts[j] = smp.PoissonNext(trueRate)
+			if rule(counts[:j+1]) {
+				n = j + 1
+				stopped++
+				break
+			}
+		}
+		total += n
+	}
+	avgStoppingN = float64(total) / float64(reps)
+	falsePositiveRate = float64(stopped) / float64(reps)
+	return
+}
@@ -0,0 +1,80 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package bayes
+
+// Summary of a sample from a posterior distribution.
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SampleSummary holds the usual summaries of a posterior sample.
+type SampleSummary struct {
+	N            int
+	Mean, SD     float64
+	Skewness     float64
+	Median       float64
+	Q1, Q3       float64 // lower and upper quartiles
+	CrILo, CrIHi float64 // equal tail credible interval
+}
+
+// sortedQtl returns the p-quantile of a sorted sample, by linear interpolation between order statistics (R type 7).
+func sortedQtl(s []float64, p float64) float64 {
+	h := float64(len(s)-1) * p
+	i := int(floor(h))
+	if i >= len(s)-1 {
+		return s[len(s)-1]
+	}
+	return s[i] + (h-float64(i))*(s[i+1]-s[i])
+}
+
+// EmpiricalQuantile returns the p-quantile of a sample, by linear interpolation between order statistics.
+// The sample is not modified.
+func EmpiricalQuantile(x []float64, p float64) float64 {
+	if len(x) == 0 {
+		panic(fmt.Sprintf("empty sample"))
+	}
+	if !(p >= 0 && p <= 1) {
+		panic(fmt.Sprintf("p must be in [0, 1]"))
+	}
+	checkFinite("sample", x...)
+	s := make([]float64, len(x))
+	copy(s, x)
+	sort.Float64s(s)
+	return sortedQtl(s, p)
+}
+
+// SummarizeSamples returns moments, quartiles and the (1-α) equal tail credible interval of a posterior sample.
+// The sample is sorted once, and not modified.
+func SummarizeSamples(samples []float64, α float64) SampleSummary {
+	// samples	draws from the posterior
+	// α		posterior probability outside the credible interval
+	n := len(samples)
+	if n < 2 {
+		panic(fmt.Sprintf("at least two samples needed"))
+	}
+	αLo, αHi := TailsFromConfidence(1 - α)
+	checkFinite("samples", samples...)
+	s := make([]float64, n)
+	copy(s, samples)
+	sort.Float64s(s)
+
+	var r SampleSummary
+	r.N = n
+	r.Mean, r.SD = meanSd(s)
+	m3 := 0.0
+	for _, x := range s {
+		d := x - r.Mean
+		m3 += d * d * d
+	}
+	m3 /= float64(n)
+	m2 := r.SD * r.SD * float64(n-1) / float64(n)
+	r.Skewness = m3 / pow(m2, 1.5)
+	r.Median = sortedQtl(s, 0.5)
+	r.Q1 = sortedQtl(s, 0.25)
+	r.Q3 = sortedQtl(s, 0.75)
+	r.CrILo = sortedQtl(s, αLo)
+	r.CrIHi = sortedQtl(s, αHi)
+	return r
+}
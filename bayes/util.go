@@ -4,6 +4,13 @@ package bayes
 
 // Some utility functions.
 
+import (
+	"fmt"
+	. "github.com/datastream/probab/dst"
+	"math/rand"
+	"time"
+)
+
 // meanSd returns mean and standard deviation of a vector.
 func meanSd(data []float64) (mean, sd float64) {
 	n := 0.0
@@ -63,3 +70,38 @@ func linInt(x, y []float64, xVal float64) float64 {
 	yVal := b*(xVal-x0)/a + y0
 	return yVal
 }
+
+// newSampler returns a Sampler drawing from rng, or from a freshly seeded source if rng is nil.
+func newSampler(rng *rand.Rand) *Sampler {
+	if rng == nil {
+		return NewSampler(time.Now().UnixNano())
+	}
+	return NewSamplerRand(rng)
+}
+
+// NormalizeLogWeights returns the probability vector proportional to exp(logw), computed by log-sum-exp,
+// so that it stays accurate when the log-weights are far from zero. Weights of -Inf get probability 0.
+func NormalizeLogWeights(logw []float64) []float64 {
+	mx := negInf
+	for _, l := range logw {
+		if isNaN(l) || isInf(l, 1) {
+			panic(fmt.Sprintf("log-weights must not be NaN or +Inf"))
+		}
+		if l > mx {
+			mx = l
+		}
+	}
+	if isInf(mx, -1) {
+		panic(fmt.Sprintf("log-weights must not all be -Inf"))
+	}
+	w := make([]float64, len(logw))
+	sum := 0.0
+	for i, l := range logw {
+		w[i] = exp(l - mx)
+		sum += w[i]
+	}
+	for i := range w {
+		w[i] /= sum
+	}
+	return w
+}
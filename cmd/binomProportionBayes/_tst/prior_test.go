@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBetaPrior(t *testing.T) {
+	fmt.Println("test of the prior flags")
+	if a, b, err := betaPrior("Beta(α=2, β=5)", 0, 0, map[string]bool{"prior": true}, false); err != nil || a != 2 || b != 5 {
+		t.Error()
+		fmt.Println(a, b, err)
+	}
+	if a, b, err := betaPrior("", 3, 4, map[string]bool{"a": true, "b": true}, false); err != nil || a != 3 || b != 4 {
+		t.Error()
+		fmt.Println(a, b, err)
+	}
+	if a, b, err := betaPrior("", 3, 4, map[string]bool{"a": true}, true); err != nil || a != 3 || b != 4 { // b from the input
+		t.Error()
+		fmt.Println(a, b, err)
+	}
+	for _, c := range []struct {
+		prior string
+		set   map[string]bool
+		input bool
+	}{
+		{"Jeffreys", map[string]bool{"prior": true, "a": true}, false},
+		{"Jeffreys", map[string]bool{"prior": true}, true}, // a, b also in the input
+		{"Beta(2, 5,", map[string]bool{"prior": true}, false},
+		{"Normal(0, 1)", map[string]bool{"prior": true}, false},
+	} {
+		if _, _, err := betaPrior(c.prior, 1, 1, c.set, c.input); err == nil {
+			t.Error()
+			fmt.Println("failed: accepted ", c.prior)
+		}
+	}
+}
@@ -1,19 +1,61 @@
 // Summary of the posterior distribution of the binomial parameter. 
+//
+// Input: k n [a b], the number of successes k in n trials, and optionally the parameters a and b of the beta prior.
+// The prior can be given instead with -prior, e.g. -prior=flat, -prior=Jeffreys or -prior="Beta(α=2, β=5)",
+// or with -a and -b.
 package main
 
 import (
-	"github.com/datastream/probab/bayes"
+	"errors"
+	"flag"
 	"fmt"
+	"github.com/datastream/probab/bayes"
 	"math"
+	"os"
 )
 
+// betaPrior returns the beta prior selected by the flags: -prior, or else a and b, from -a and -b or the input.
+// set holds the names of the flags given on the command line, and input reports whether a and b were read from the input.
+func betaPrior(prior string, a, b float64, set map[string]bool, input bool) (float64, float64, error) {
+	if prior != "" {
+		if set["a"] || set["b"] || input {
+			return 0, 0, errors.New("give the prior either with -prior, or with -a and -b or the input")
+		}
+		p, err := bayes.ParseBetaPrior(prior)
+		return p.A, p.B, err
+	}
+	if a < 0 || b < 0 {
+		return 0, 0, errors.New("The parameters of the prior must be non-negative")
+	}
+	return a, b, nil
+}
+
 // Summary of the posterior distribution of the binomial parameter. 
 func main() {
 	var (
 		k, n int64
 		a, b float64
 	)
-	fmt.Scanf("%d %d %f %f", k, n, a, b)
+	prior := flag.String("prior", "", `prior of π: flat, Jeffreys, or a beta distribution, e.g. "Beta(α=2, β=5)"`)
+	aFlag := flag.Float64("a", 0, "first shape parameter of the beta prior")
+	bFlag := flag.Float64("b", 0, "second shape parameter of the beta prior")
+	flag.Parse()
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { set[f.Name] = true })
+
+	// the input holds two numbers, or four with the prior
+	got, _ := fmt.Scanf("%d %d %f %f", &k, &n, &a, &b)
+	if set["a"] {
+		a = *aFlag
+	}
+	if set["b"] {
+		b = *bFlag
+	}
+	a, b, err := betaPrior(*prior, a, b, set, got > 2)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
 	pr := []float64{0.005, 0.01, 0.025, 0.05, 0.5, 0.95, 0.975, 0.99, 0.995}
 
 	/*
@@ -39,11 +81,11 @@ func main() {
 	fmt.Println("Posterior Mean           : ", bayes.BinomPiPostMean(a, b, n, k))
 	fmt.Println("Posterior Variance       : ", bayes.BinomPiPostVar(a, b, n, k))
 
-	fmt.Println("\nProb.\t\tQuantile \n")
+	fmt.Print("\nProb.\t\tQuantile \n\n")
 	for i := 0; i < 9; i++ {
 		qf := bayes.BinomPiQtlBPri(k, n, a, b)
 		qtl := qf(pr[i])
 		fmt.Println(pr[i], "\t\t", qtl)
 	}
-	fmt.Println("\n")
+	fmt.Print("\n\n")
 }
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"github.com/datastream/probab/bayes"
+	"testing"
+)
+
+func TestGammaPrior(t *testing.T) {
+	fmt.Println("test of the prior flags")
+	none := map[string]bool{}
+	ok := []struct {
+		prior string
+		r, v  float64
+		set   map[string]bool
+		input bool
+		want  bayes.GammaPrior
+	}{
+		{"Gamma(shape=2, rate=1)", 0, 0, map[string]bool{"prior": true}, false, bayes.GammaPrior{R: 2, V: 1}},
+		{"flat", 0, 0, map[string]bool{"prior": true}, false, bayes.GammaPrior{R: 1, V: 0}},
+		{"Jeffreys", 0, 0, map[string]bool{"prior": true}, false, bayes.GammaPrior{R: 0.5, V: 0}},
+		{"", 2.5, 0.5, map[string]bool{"r": true}, true, bayes.GammaPrior{R: 2.5, V: 0.5}}, // v from the input
+		{"", 2.5, 0.5, map[string]bool{"r": true, "v": true}, false, bayes.GammaPrior{R: 2.5, V: 0.5}},
+		{"", 2.5, 0.5, none, true, bayes.GammaPrior{R: 2.5, V: 0.5}},
+	}
+	for _, c := range ok {
+		if g, err := gammaPrior(c.prior, c.r, c.v, c.set, c.input); err != nil || g != c.want {
+			t.Error()
+			fmt.Println(c.prior, g, err)
+		}
+	}
+	bad := []struct {
+		prior string
+		r, v  float64
+		set   map[string]bool
+		input bool
+	}{
+		{"Gamma(shape=2, rate=1)", 2, 1, map[string]bool{"prior": true, "r": true, "v": true}, false},
+		{"Gamma(2, 1", 0, 0, map[string]bool{"prior": true}, false},
+		{"Gamma(2)", 0, 0, map[string]bool{"prior": true}, false},
+		{"Beta(2, 1)", 0, 0, map[string]bool{"prior": true}, false},
+		{"gamma(-2, 1)", 0, 0, map[string]bool{"prior": true}, false},
+		{"", -1, 1, map[string]bool{"r": true, "v": true}, false},
+		{"Jeffreys", 3, 4, map[string]bool{"prior": true}, true}, // r, v also in the input
+	}
+	for _, c := range bad {
+		if _, err := gammaPrior(c.prior, c.r, c.v, c.set, c.input); err == nil {
+			t.Error()
+			fmt.Println("failed: accepted ", c.prior, c.r, c.v)
+		}
+	}
+}
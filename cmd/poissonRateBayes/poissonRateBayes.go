@@ -1,31 +1,68 @@
 // Summary of the posterior distribution of the Poisson parameter. 
+//
+// Input: x n [r v], the total number of events x in n equal time intervals, and optionally the shape r and rate v
+// of the gamma prior. The prior can be given instead with -prior, e.g. -prior=flat, -prior=Jeffreys or
+// -prior="Gamma(shape=2, rate=1)", or with -r and -v.
 
 package main
 
 import (
-	"github.com/datastream/probab/bayes"
+	"errors"
+	"flag"
 	"fmt"
+	"github.com/datastream/probab/bayes"
+	"os"
 )
 
+// gammaPrior returns the gamma prior selected by the flags: -prior, or else r and v, from -r and -v or the input.
+// set holds the names of the flags given on the command line, and input reports whether r and v were read from the input.
+func gammaPrior(prior string, r, v float64, set map[string]bool, input bool) (bayes.GammaPrior, error) {
+	if prior != "" {
+		if set["r"] || set["v"] || input {
+			return bayes.GammaPrior{}, errors.New("give the prior either with -prior, or with -r and -v or the input")
+		}
+		return bayes.ParseGammaPrior(prior)
+	}
+	if r < 0 || v < 0 {
+		return bayes.GammaPrior{}, errors.New("Shape parameter r and rate parameter v must be greater than or equal to zero")
+	}
+	return bayes.GammaPrior{R: r, V: v}, nil
+}
+
 // Summary of the posterior distribution of the Poisson parameter. 
 func main() {
 	var (
 		x, n int64
 		r, v float64
 	)
+	prior := flag.String("prior", "", `prior of λ: flat, Jeffreys, or a gamma distribution, e.g. "Gamma(shape=2, rate=1)"`)
+	rFlag := flag.Float64("r", 0, "shape of the gamma prior")
+	vFlag := flag.Float64("v", 0, "rate of the gamma prior")
+	flag.Parse()
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { set[f.Name] = true })
 
-	fmt.Scanf("%d %d %f %f", &x, &n, &r, &v)
+	// the input holds two numbers, or four with the prior
+	got, _ := fmt.Scanf("%d %d %f %f", &x, &n, &r, &v)
 	// fmt.Println("%d %d %f %f", x, n, r, v)
+	if set["r"] {
+		r = *rFlag
+	}
+	if set["v"] {
+		v = *vFlag
+	}
+	g, err := gammaPrior(*prior, r, v, set, got > 2)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
 
 	pr := []float64{0.005, 0.01, 0.025, 0.05, 0.5, 0.95, 0.975, 0.99, 0.995}
 
-	if r < 0 || v < 0 {
-		panic("Shape parameter r and rate parameter v must be greater than or equal to zero")
-	}
-	fmt.Println("\nProb.\t\tQuantile \n")
+	fmt.Print("\nProb.\t\tQuantile \n\n")
 	for i := 0; i < 9; i++ {
-		qtl := bayes.PoissonLambdaQtlGPri(x, n, r, v)
+		qtl := bayes.PoissonLambdaQtlGPri(x, n, g.R, g.V)
 		fmt.Println(pr[i], "\t\t", qtl(pr[i]))
 	}
-	fmt.Println("\n")
+	fmt.Print("\n\n")
 }
@@ -0,0 +1,67 @@
+// test of Beta distribution against closed forms: arcsine, uniform, and binomial sums for integer α, β
+package dst
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestBetaDist(t *testing.T) {
+	ab := [][2]float64{{0.5, 0.5}, {1, 1}, {2, 5}, {10, 3}}
+	x := []float64{0.1, 0.5, 0.8}
+	pdf := [][]float64{
+		{1.061032954, 0.6366197724, 0.7957747155},
+		{1, 1, 1},
+		{1.9683, 0.9375, 0.0384},
+		{5.346e-07, 0.322265625, 3.543348019},
+	}
+	cdf := [][]float64{
+		{0.2048327647, 0.5, 0.7048327647},
+		{0.1, 0.5, 0.8},
+		{0.114265, 0.890625, 0.9984},
+		{5.455e-09, 0.01928710938, 0.5583457485},
+	}
+	p := []float64{0.025, 0.5, 0.975}
+	qtl := [][]float64{
+		{0.001541333133, 0.5, 0.9984586669},
+		{0.025, 0.5, 0.975},
+		{0.04327186829, 0.2644499833, 0.641234579},
+		{0.5158622513, 0.7833135892, 0.9451393555},
+	}
+
+	fmt.Println("test of Beta distribution: PDF, CDF, Qtl")
+	for i, c := range ab {
+		for j := range x {
+			if y := BetaPDFAt(c[0], c[1], x[j]); !check(y, pdf[i][j]) {
+				t.Error()
+				fmt.Println("PDF", c, x[j], y, pdf[i][j])
+			}
+			if y := BetaCDFAt(c[0], c[1], x[j]); !check(y, cdf[i][j]) {
+				t.Error()
+				fmt.Println("CDF", c, x[j], y, cdf[i][j])
+			}
+			if y := BetaQtlFor(c[0], c[1], p[j]); !check(y, qtl[i][j]) {
+				t.Error()
+				fmt.Println("Qtl", c, p[j], y, qtl[i][j])
+			}
+		}
+	}
+
+	fmt.Println("test of Beta distribution: Next, sample mean = α/(α+β)")
+	rand.Seed(1)
+	const n = 100000
+	for _, c := range ab {
+		sum := 0.0
+		for i := 0; i < n; i++ {
+			sum += BetaNext(c[0], c[1])
+		}
+		mean := c[0] / (c[0] + c[1])
+		sd := sqrt(c[0] * c[1] / ((c[0] + c[1]) * (c[0] + c[1]) * (c[0] + c[1] + 1)))
+		// 5 standard errors of the mean
+		if abs(sum/n-mean) > 5*sd/sqrt(n) {
+			t.Error()
+			fmt.Println(c, sum/n, mean)
+		}
+	}
+}
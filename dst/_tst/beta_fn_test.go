@@ -0,0 +1,69 @@
+// test of Beta function
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLnBetaFn(t *testing.T) {
+	fmt.Println("test of LnBetaFn against lgamma")
+	a := []float64{2, 0.5, 7.3, 12.5, 0.01}
+	b := []float64{3, 0.5, 12.1, 30, 4}
+	for i := range a {
+		la, _ := math.Lgamma(a[i])
+		lb, _ := math.Lgamma(b[i])
+		lab, _ := math.Lgamma(a[i] + b[i])
+		x := LnBetaFn(a[i], b[i])
+		y := la + lb - lab
+		if abs(x-y) > 1e-12*abs(y) {
+			t.Error()
+			fmt.Println(a[i], b[i], x, y)
+		}
+		// symmetry
+		if LnBetaFn(b[i], a[i]) != x {
+			t.Error()
+			fmt.Println("not symmetric: ", a[i], b[i])
+		}
+	}
+
+	fmt.Println("test of LnBetaFn for large arguments")
+	// asymptotic expansion: log B(1/2, q) = log(sqrt(π/q)) + 1/(8q) + O(q^-3)
+	x := LnBetaFn(0.5, 1e6)
+	y := -6.335390211057437
+	if abs(x-y) > 1e-12 {
+		t.Error()
+		fmt.Println(x, y)
+	}
+	x = LnBetaFn(300, 400)
+	y = -479.6884510371319
+	if abs(x-y) > 1e-10 {
+		t.Error()
+		fmt.Println(x, y)
+	}
+	if isInf(LnBetaFn(1e10, 1e10), 0) || isNaN(LnBetaFn(1e10, 1e10)) {
+		t.Error()
+		fmt.Println("LnBetaFn(1e10, 1e10) not finite")
+	}
+}
+
+func TestBetaFn(t *testing.T) {
+	fmt.Println("test of BetaFn")
+	a := []float64{2, 0.5, 1, 3}
+	b := []float64{3, 0.5, 4, 5}
+	y := []float64{1.0 / 12, π, 0.25, 1.0 / 105}
+	for i := range a {
+		x := BetaFn(a[i], b[i])
+		if !check(x, y[i]) {
+			t.Error()
+			fmt.Println(a[i], b[i], x, y[i])
+		}
+	}
+	// Γ(300) overflows, but B(300, 400) does not
+	x := BetaFn(300, 400)
+	if x == 0 || isInf(x, 0) || !check(log(x), -479.6884510371319) {
+		t.Error()
+		fmt.Println(x)
+	}
+}
@@ -0,0 +1,73 @@
+// test of BetaQtl against closed forms
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestBetaQtl(t *testing.T) {
+	fmt.Println("test of BetaQtl: closed forms, U-shaped and J-shaped densities")
+	closed := []struct {
+		α, β float64
+		qtl  func(p float64) float64
+	}{
+		{0.5, 0.5, func(p float64) float64 { return math.Pow(math.Sin(π*p/2), 2) }}, // arcsine
+		{0.2, 1, func(p float64) float64 { return math.Pow(p, 1/0.2) }},
+		{1, 0.3, func(p float64) float64 { return -math.Expm1(math.Log1p(-p) / 0.3) }},
+		{3, 1, func(p float64) float64 { return math.Pow(p, 1.0/3) }},
+	}
+	for _, c := range closed {
+		for _, p := range []float64{1e-12, 0.001, 0.1, 0.5, 0.9, 0.999} {
+			x := BetaQtlFor(c.α, c.β, p)
+			y := c.qtl(p)
+			if !check(x, y) {
+				t.Error()
+				fmt.Println(c.α, c.β, p, x, y)
+			}
+		}
+	}
+
+	fmt.Println("test of BetaQtl: CDF(Qtl(p)) = p")
+	for _, ab := range [][2]float64{{0.2, 0.7}, {0.3, 3}, {2, 5}, {50, 0.4}} {
+		cdf := BetaCDF(ab[0], ab[1])
+		for _, p := range []float64{1e-10, 0.01, 0.5, 0.99} {
+			x := BetaQtlFor(ab[0], ab[1], p)
+			if x < 0 || x > 1 || !check(cdf(x), p) {
+				t.Error()
+				fmt.Println(ab, p, x, cdf(x))
+			}
+		}
+	}
+
+	fmt.Println("test of BetaQtl: p = 0 and p = 1")
+	for _, ab := range [][2]float64{{0.5, 0.5}, {2, 5}} {
+		if x := BetaQtlFor(ab[0], ab[1], 0); x != 0 {
+			t.Error()
+			fmt.Println(ab, 0, x)
+		}
+		if x := BetaQtlFor(ab[0], ab[1], 1); x != 1 {
+			t.Error()
+			fmt.Println(ab, 1, x)
+		}
+	}
+}
+
+func TestBetaQtlTiny(t *testing.T) {
+	fmt.Println("test of BetaQtl: quantiles of Beta(α, 1) down to 1e-302")
+	// the quantile of Beta(α, 1) is p^(1/α)
+	for _, c := range [][2]float64{{0.01, 0.001}, {0.001, 0.5}, {0.002, 0.3}, {0.05, 1e-12}} {
+		x, ok := BetaQtlConv(c[0], 1, c[1])
+		y := math.Pow(c[1], 1/c[0])
+		if !ok || !check(x, y) {
+			t.Error()
+			fmt.Println(c, x, ok, y)
+		}
+	}
+	// below the least positive float64 it is not reported as converged
+	if x, ok := BetaQtlConv(0.001, 1, 1e-5); ok {
+		t.Error()
+		fmt.Println("failed: converged below the least float64 ", x)
+	}
+}
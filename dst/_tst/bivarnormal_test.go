@@ -0,0 +1,87 @@
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestBivariateNormal(t *testing.T) {
+	fmt.Println("test for BivariateNormal")
+	μ1, μ2, σ1, σ2 := 1.0, -2.0, 0.5, 3.0
+
+	// the marginal of X1 is Normal(μ1, σ1): integrate the PDF over x2
+	pdf := BivariateNormalPDF(μ1, μ2, σ1, σ2, 0.7)
+	for _, x1 := range []float64{0, 0.8, 1.5} {
+		const m = 4000
+		lo, hi := μ2-12*σ2, μ2+12*σ2
+		h := (hi - lo) / m
+		s := 0.0
+		for i := 0; i <= m; i++ {
+			w := 1.0
+			if i == 0 || i == m {
+				w = 0.5
+			}
+			s += w * pdf(x1, lo+float64(i)*h)
+		}
+		s *= h
+		if y := NormalPDFAt(μ1, σ1, x1); !check(s, y) {
+			fmt.Println("failed: marginal ", x1, s, y)
+			t.Error()
+		}
+	}
+
+	// ρ = 0: the joint PDF factors
+	for _, x := range [][2]float64{{0, 0}, {1.3, -4}, {-0.2, 2.5}} {
+		p := BivariateNormalPDFAt(μ1, μ2, σ1, σ2, 0, x[0], x[1])
+		q := NormalPDFAt(μ1, σ1, x[0]) * NormalPDFAt(μ2, σ2, x[1])
+		if !check(p, q) {
+			fmt.Println("failed: independence ", x, p, q)
+			t.Error()
+		}
+		if μ, σ := BivariateNormalCond(μ1, μ2, σ1, σ2, 0, x[1]); μ != μ1 || σ != σ1 {
+			fmt.Println("failed: conditional under independence ", μ, σ)
+			t.Error()
+		}
+	}
+
+	// sample moments and correlation
+	smp := NewSampler(1)
+	const n = 200000
+	for _, ρ := range []float64{0, -0.6} {
+		var s1, s2, s11, s22, s12 float64
+		for i := 0; i < n; i++ {
+			x1, x2 := smp.BivariateNormalNext(μ1, μ2, σ1, σ2, ρ)
+			s1 += x1
+			s2 += x2
+			s11 += x1 * x1
+			s22 += x2 * x2
+			s12 += x1 * x2
+		}
+		m1, m2 := s1/n, s2/n
+		v1, v2 := s11/n-m1*m1, s22/n-m2*m2
+		r := (s12/n - m1*m2) / math.Sqrt(v1*v2)
+		if math.Abs(m1-μ1) > 0.01 || math.Abs(m2-μ2) > 0.05 ||
+			math.Abs(math.Sqrt(v1)/σ1-1) > 0.01 || math.Abs(math.Sqrt(v2)/σ2-1) > 0.01 ||
+			math.Abs(r-ρ) > 0.01 {
+			fmt.Println("failed: sample ", ρ, m1, m2, math.Sqrt(v1), math.Sqrt(v2), r)
+			t.Error()
+		}
+	}
+
+	// the same seed gives the same pairs
+	a, b := NewSampler(7), NewSampler(7)
+	for i := 0; i < 10; i++ {
+		x1, x2 := a.BivariateNormalNext(μ1, μ2, σ1, σ2, 0.3)
+		y1, y2 := b.BivariateNormalNext(μ1, μ2, σ1, σ2, 0.3)
+		if x1 != y1 || x2 != y2 {
+			fmt.Println("failed: not reproducible ", x1, x2, y1, y2)
+			t.Error()
+		}
+	}
+
+	if p := BivariateNormalPDFAt(0, 0, 1, 1, 1, 0, 0); !isNaN(p) {
+		fmt.Println("failed: expected NaN for ρ = 1, got ", p)
+		t.Error()
+	}
+}
@@ -0,0 +1,34 @@
+// test of noncentral ChiSquare distribution against numerical integration
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNoncentralChiSquareCDF(t *testing.T) {
+	fmt.Println("test of noncentral ChiSquare distribution: CDF")
+	k := []float64{3, 10, 1, 4}
+	λ := []float64{2, 4.5, 1, 100}
+	x := []float64{5, 20, 1, 150}
+	y := []float64{0.59340518, 0.82439914, 0.47724987, 0.98265568}
+	for i := range k {
+		p := NoncentralChiSquareCDFAt(k[i], λ[i], x[i])
+		if abs(p-y[i]) > 1e-6 {
+			t.Error()
+			fmt.Println(k[i], λ[i], x[i], p, y[i])
+		}
+	}
+
+	fmt.Println("test of noncentral ChiSquare distribution: λ = 0 is central")
+	for _, x := range []float64{0.5, 3, 12} {
+		if !check(NoncentralChiSquareCDFAt(5, 0, x), ChiSquareCDFAt(5, x)) {
+			t.Error()
+			fmt.Println(x, NoncentralChiSquareCDFAt(5, 0, x), ChiSquareCDFAt(5, x))
+		}
+		if abs(NoncentralChiSquareCDFAt(5, 1e-10, x)-ChiSquareCDFAt(5, x)) > 1e-8 {
+			t.Error()
+			fmt.Println(x, NoncentralChiSquareCDFAt(5, 1e-10, x), ChiSquareCDFAt(5, x))
+		}
+	}
+}
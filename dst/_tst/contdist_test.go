@@ -0,0 +1,115 @@
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestContDist(t *testing.T) {
+	fmt.Println("test of ContDist adapters")
+	dists := []ContDist{NormalDist{Mu: 1, Sigma: 2}, GammaDist{Shape: 3, Rate: 2}, BetaDist{A: 2, B: 5}}
+	x := []float64{0.5, 1.2, 0.3}
+	// the same values as the functions they wrap
+	pdf := []float64{NormalPDFAt(1, 2, 0.5), GammaPDFAt(3, 0.5, 1.2), BetaPDFAt(2, 5, 0.3)}
+	cdf := []float64{NormalCDFAt(1, 2, 0.5), GammaCDFAt(3, 0.5, 1.2), BetaCDFAt(2, 5, 0.3)}
+	mean := []float64{1, 1.5, 2.0 / 7}
+	for i, d := range dists {
+		if d.PDF(x[i]) != pdf[i] || d.CDF(x[i]) != cdf[i] {
+			t.Error()
+			fmt.Println(i, d.PDF(x[i]), pdf[i], d.CDF(x[i]), cdf[i])
+		}
+		if p := d.CDF(d.Qtl(0.3)); !check(p, 0.3) {
+			t.Error()
+			fmt.Println(i, p)
+		}
+		const n = 100000
+		sum := 0.0
+		for j := 0; j < n; j++ {
+			sum += d.Rand()
+		}
+		if abs(sum/n-mean[i]) > 0.02 {
+			t.Error()
+			fmt.Println(i, sum/n, mean[i])
+		}
+	}
+}
+
+func TestContDistString(t *testing.T) {
+	fmt.Println("test of ContDist String")
+	dists := []fmt.Stringer{NormalDist{1.2, 0.3}, GammaDist{3.5, 2}, BetaDist{2, 5}}
+	want := []string{"Normal(μ=1.2, σ=0.3)", "Gamma(shape=3.5, rate=2)", "Beta(α=2, β=5)"}
+	for i, d := range dists {
+		if d.String() != want[i] || fmt.Sprint(d) != want[i] {
+			t.Error()
+			fmt.Println(d.String(), want[i])
+		}
+	}
+
+	// the parameters read back exactly
+	g := GammaDist{1. / 3, 1e-7}
+	var back GammaDist
+	if _, err := fmt.Sscanf(g.String(), "Gamma(shape=%g, rate=%g)", &back.Shape, &back.Rate); err != nil || back != g {
+		t.Error()
+		fmt.Println(g, back, err)
+	}
+	n := NormalDist{-2.718281828459045, 0.1}
+	var nback NormalDist
+	if _, err := fmt.Sscanf(n.String(), "Normal(μ=%g, σ=%g)", &nback.Mu, &nback.Sigma); err != nil || nback != n {
+		t.Error()
+		fmt.Println(n, nback, err)
+	}
+}
+
+func TestParseDistribution(t *testing.T) {
+	fmt.Println("test of ParseDistribution")
+	// String and ParseDistribution round-trip every family
+	dists := []ContDist{NormalDist{-2.718281828459045, 0.1}, GammaDist{1. / 3, 1e-7}, BetaDist{0.5, 123.25}}
+	for _, d := range dists {
+		back, err := ParseDistribution(fmt.Sprint(d))
+		if err != nil || back != d {
+			t.Error()
+			fmt.Println(d, back, err)
+		}
+	}
+
+	ok := map[string]ContDist{
+		"Gamma(3.5,2.0)":            GammaDist{3.5, 2},
+		"  gamma ( 3.5 ,  2 ) ":     GammaDist{3.5, 2},
+		"Gamma(rate=2, shape=3.5)":  GammaDist{3.5, 2},
+		"Normal(1.2,0.3)":           NormalDist{1.2, 0.3},
+		"Normal(mu=1.2, sigma=0.3)": NormalDist{1.2, 0.3},
+		"Normal(1.2, σ=0.3)":        NormalDist{1.2, 0.3},
+		"BETA(alpha=2, beta=5)":     BetaDist{2, 5},
+		"Beta(b=5, a=2)":            BetaDist{2, 5},
+		"Beta(\t2e0,\n5 )":          BetaDist{2, 5},
+	}
+	for s, want := range ok {
+		d, err := ParseDistribution(s)
+		if err != nil || d != want {
+			t.Error()
+			fmt.Println(s, d, err)
+		}
+	}
+
+	bad := []string{
+		"", "Gamma", "Gamma(3.5, 2", "(3.5, 2)", "Gama(3.5, 2)", "Weibull(1, 2)",
+		"Gamma()", "Gamma(3.5)", "Gamma(3.5, 2, 1)", "Normal(1,, 2)",
+		"Gamma(shape=1, shape=2)", "Gamma(2, shape=1)", "Gamma(scale=1, rate=2)",
+		"Gamma(x, 2)", "Normal(NaN, 1)", "Normal(0, Inf)",
+		"Normal(0, 0)", "Gamma(-1, 2)", "Beta(2, 0)",
+	}
+	for _, s := range bad {
+		if d, err := ParseDistribution(s); err == nil || d != nil {
+			t.Error()
+			fmt.Println("failed: accepted ", s, d)
+		}
+	}
+	if _, err := ParseDistribution("Weibull(1, 2)"); err == nil || err.Error() != `dst: unknown distribution family "Weibull"` {
+		t.Error()
+		fmt.Println(err)
+	}
+	if _, err := ParseDistribution("Gamma(3.5)"); err == nil || err.Error() != "dst: Gamma takes 2 parameters, got 1" {
+		t.Error()
+		fmt.Println(err)
+	}
+}
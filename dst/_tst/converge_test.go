@@ -0,0 +1,61 @@
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestQtlConv(t *testing.T) {
+	fmt.Println("test for QtlConv")
+	x, ok := BetaQtlConv(2, 3, 0.3)
+	if !ok || !check(BetaCDFAt(2, 3, x), 0.3) {
+		fmt.Println("failed: ", x, ok)
+		t.Error()
+	}
+	x, ok = GammaQtlConv(3, 2, 0.3)
+	if !ok || !check(GammaCDFAt(3, 2, x), 0.3) {
+		fmt.Println("failed: ", x, ok)
+		t.Error()
+	}
+
+	// the bracketed Newton steps of BetaQtl reach 1e-15 here in 5 iterations, so cap them below that
+	x, ok = BetaQtlConvOpts(2, 3, 0.3, QtlOpts{Tol: 1e-15, MaxIter: 2})
+	if ok {
+		fmt.Println("failed: not reported as non-converged ", x)
+		t.Error()
+	}
+	x, ok = GammaQtlConvOpts(3, 2, 0.3, QtlOpts{Tol: 1e-15, MaxIter: 0})
+	if ok {
+		fmt.Println("failed: not reported as non-converged ", x)
+		t.Error()
+	}
+}
+
+func TestQtlNonFinite(t *testing.T) {
+	fmt.Println("test for Qtl with non-finite input")
+	for _, c := range [][3]float64{{NaN, 2, 0.3}, {posInf, 2, 0.3}, {3, posInf, 0.3}, {3, 2, NaN}} {
+		if x, ok := GammaQtlConv(c[0], c[1], c[2]); ok || !isNaN(x) {
+			fmt.Println("failed: GammaQtl ", c, x, ok)
+			t.Error()
+		}
+		if x, ok := BetaQtlConv(c[0], c[1], c[2]); ok || !isNaN(x) {
+			fmt.Println("failed: BetaQtl ", c, x, ok)
+			t.Error()
+		}
+	}
+}
+
+func TestGammaQtlConvLargeShape(t *testing.T) {
+	fmt.Println("test for GammaQtlConv with large shape at small p")
+	// the Wilson-Hilferty approximation k(1 - 1/(9k) + z/(3√k))³ is good to about k^(-3/2) here
+	for _, k := range []float64{1e4, 3e4, 1e5} {
+		for _, p := range []float64{1e-8, 1e-12} {
+			x, ok := GammaQtlConv(k, 2, p)
+			wh := 2 * k * pow(1-1/(9*k)+ZQtlFor(p)/(3*sqrt(k)), 3)
+			if !ok || !(abs(x/wh-1) < 1e-5) || isNaN(GammaQtlFor(k, 2, p)) {
+				fmt.Println("failed: ", k, p, x, ok, wh)
+				t.Error()
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// test of Dirichlet distribution
+package dst
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// uniformSimplex returns a point drawn uniformly from the simplex of dimension k-1, from sorted uniforms.
+func uniformSimplex(k int, rng *rand.Rand) []float64 {
+	u := make([]float64, k+1)
+	for i := 1; i < k; i++ {
+		u[i] = rng.Float64()
+	}
+	u[k] = 1
+	sort.Float64s(u)
+	x := make([]float64, k)
+	for i := range x {
+		x[i] = u[i+1] - u[i]
+	}
+	return x
+}
+
+func TestDirichlet(t *testing.T) {
+	fmt.Println("test of Dirichlet distribution: PDF integrates to 1 over the simplex")
+	rng := rand.New(rand.NewSource(1))
+	for _, α := range [][]float64{{2, 3, 4}, {1, 1, 1}, {1.5, 0.8, 2, 3}} {
+		pdf := DirichletPDF(α)
+		const n = 200000
+		sum := 0.0
+		for i := 0; i < n; i++ {
+			sum += pdf(uniformSimplex(len(α), rng))
+		}
+		// the uniform density on the simplex is (k-1)!
+		vol := 1.0
+		for i := 2; i < len(α); i++ {
+			vol *= float64(i)
+		}
+		if x := sum / n / vol; abs(x-1) > 0.02 {
+			t.Error()
+			fmt.Println(α, x)
+		}
+	}
+
+	fmt.Println("test of Dirichlet distribution: PDF, large α does not overflow")
+	α := []float64{200, 300, 400}
+	x := DirichletMean(α)
+	if y := DirichletPDFAt(α, x); isNaN(y) || isInf(y, 0) || y <= 0 {
+		t.Error()
+		fmt.Println(y)
+	}
+	if !isNaN(DirichletPDFAt([]float64{1, 0}, []float64{0.5, 0.5})) {
+		t.Error("α = 0 accepted")
+	}
+	if DirichletPDFAt([]float64{2, 2}, []float64{0.5, 0.6}) != 0 {
+		t.Error("point off the simplex")
+	}
+
+	fmt.Println("test of Dirichlet distribution: Next, mean and variance")
+	rand.Seed(1)
+	α = []float64{2, 3, 4}
+	const n = 100000
+	m := make([]float64, 3)
+	v := make([]float64, 3)
+	for i := 0; i < n; i++ {
+		x := DirichletNext(α)
+		for j := range x {
+			m[j] += x[j]
+			v[j] += x[j] * x[j]
+		}
+	}
+	mean, vari := DirichletMean(α), DirichletVar(α)
+	for j := range m {
+		m[j] /= n
+		v[j] = v[j]/n - m[j]*m[j]
+		if abs(m[j]-mean[j]) > 5*sqrt(vari[j]/n) || abs(v[j]/vari[j]-1) > 0.02 {
+			t.Error()
+			fmt.Println(j, m[j], mean[j], v[j], vari[j])
+		}
+	}
+
+	fmt.Println("test of Dirichlet distribution: Next, Dirichlet(1, 1, 1) is uniform on the simplex")
+	// each component is Beta(1, 2): P(θi < 0.5) = 0.75
+	below := make([]int, 3)
+	for i := 0; i < n; i++ {
+		x := DirichletNext([]float64{1, 1, 1})
+		for j := range x {
+			if x[j] < 0.5 {
+				below[j]++
+			}
+		}
+	}
+	for j := range below {
+		if p := float64(below[j]) / n; abs(p-0.75) > 0.01 {
+			t.Error()
+			fmt.Println(j, p)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// test of EvalAt and the PDFSlice functions against the closures
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// grid returns n points evenly spaced over [lo, hi].
+func grid(lo, hi float64, n int) []float64 {
+	xs := make([]float64, n)
+	for i := range xs {
+		xs[i] = lo + (hi-lo)*float64(i)/float64(n-1)
+	}
+	return xs
+}
+
+func same(x, y float64) bool {
+	if x == y || (math.IsNaN(x) && math.IsNaN(y)) {
+		return true
+	}
+	return check(x, y)
+}
+
+func TestPDFSlice(t *testing.T) {
+	fmt.Println("test of EvalAt, GammaPDFSlice, BetaPDFSlice")
+	xs := grid(-1, 30, 311)
+	for _, c := range [][2]float64{{0.5, 2}, {1, 3}, {2.5, 0.7}, {40, 0.25}, {2e5, 1e-4}, {-1, 1}} {
+		pdf := GammaPDF(c[0], c[1])
+		ys := GammaPDFSlice(c[0], c[1], xs)
+		for i, x := range xs {
+			if !same(ys[i], pdf(x)) {
+				t.Error()
+				fmt.Println("Gamma", c, x, ys[i], pdf(x))
+			}
+		}
+	}
+	xs = grid(-0.1, 1.1, 121)
+	for _, c := range [][2]float64{{0.5, 0.5}, {1, 1}, {1, 3}, {2, 5}, {30, 12}, {0.2, 1}} {
+		pdf := BetaPDF(c[0], c[1])
+		ys := BetaPDFSlice(c[0], c[1], xs)
+		for i, x := range xs {
+			if !same(ys[i], pdf(x)) {
+				t.Error()
+				fmt.Println("Beta", c, x, ys[i], pdf(x))
+			}
+		}
+	}
+	ys := EvalAt(NormalCDF(0, 1), []float64{-1, 0, 1})
+	if len(ys) != 3 || !check(ys[1], 0.5) || !check(ys[0]+ys[2], 1) {
+		t.Error()
+		fmt.Println(ys)
+	}
+	if len(GammaPDFSlice(2, 1, nil)) != 0 {
+		t.Error()
+	}
+}
+
+var benchGrid = grid(0.001, 20, 10000)
+
+func BenchmarkGammaPDFClosure(bm *testing.B) {
+	for j := 0; j < bm.N; j++ {
+		EvalAt(GammaPDF(3.5, 2), benchGrid)
+	}
+}
+
+func BenchmarkGammaPDFSlice(bm *testing.B) {
+	for j := 0; j < bm.N; j++ {
+		GammaPDFSlice(3.5, 2, benchGrid)
+	}
+}
+
+func BenchmarkBetaPDFClosure(bm *testing.B) {
+	xs := grid(0.0001, 0.9999, 10000)
+	bm.ResetTimer()
+	for j := 0; j < bm.N; j++ {
+		EvalAt(BetaPDF(30, 12), xs)
+	}
+}
+
+func BenchmarkBetaPDFSlice(bm *testing.B) {
+	xs := grid(0.0001, 0.9999, 10000)
+	bm.ResetTimer()
+	for j := 0; j < bm.N; j++ {
+		BetaPDFSlice(30, 12, xs)
+	}
+}
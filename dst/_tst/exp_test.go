@@ -0,0 +1,44 @@
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestExponential(t *testing.T) {
+	fmt.Println("test of Exponential distribution")
+	λ := 2.5
+	x := []float64{0.1, 0.5, 1.2}
+	for _, xi := range x {
+		cdf := 1 - math.Exp(-λ*xi)
+		if !check(ExponentialPDFAt(λ, xi), λ*math.Exp(-λ*xi)) || !check(ExponentialCDFAt(λ, xi), cdf) {
+			t.Error()
+			fmt.Println(xi, ExponentialPDFAt(λ, xi), ExponentialCDFAt(λ, xi))
+		}
+		if !check(ExponentialQtlFor(λ, cdf), xi) {
+			t.Error()
+			fmt.Println(cdf, ExponentialQtlFor(λ, cdf), xi)
+		}
+	}
+	if ExponentialPDFAt(λ, -1) != 0 || ExponentialCDFAt(λ, -1) != 0 {
+		t.Error()
+		fmt.Println("failed: nonzero below the support")
+	}
+	for _, bad := range []float64{0, -1, math.Inf(1)} {
+		if !math.IsNaN(ExponentialPDFAt(bad, 1)) || !math.IsNaN(ExponentialCDFAt(bad, 1)) ||
+			!math.IsNaN(ExponentialQtlFor(bad, 0.5)) || !math.IsNaN(ExponentialNext(bad)) {
+			t.Error()
+			fmt.Println("failed: bad λ accepted ", bad)
+		}
+	}
+	if !math.IsNaN(ExponentialQtlFor(λ, 1.5)) {
+		t.Error()
+		fmt.Println("failed: bad probability accepted")
+	}
+	// ChiSquare(2) is Exponential(1/2)
+	if !check(ChiSquarePDFAt(2, 1.3), ExponentialPDFAt(0.5, 1.3)) {
+		t.Error()
+		fmt.Println("failed: ChiSquarePDF ", ChiSquarePDFAt(2, 1.3), ExponentialPDFAt(0.5, 1.3))
+	}
+}
@@ -0,0 +1,54 @@
+// test of log-factorial and log-binomial coefficient
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLogChoose(t *testing.T) {
+	fmt.Println("test of LogFactorial")
+	f := 1.0
+	for n := int64(0); n <= 20; n++ {
+		if n > 0 {
+			f *= float64(n)
+		}
+		if !check(exp(LogFactorial(n)), f) {
+			t.Error()
+			fmt.Println(n, exp(LogFactorial(n)), f)
+		}
+	}
+	// log(1000!), beyond the table
+	if abs(LogFactorial(1000)-5912.128178488163) > 1e-9 {
+		t.Error()
+		fmt.Println(LogFactorial(1000))
+	}
+
+	fmt.Println("test of LogChoose")
+	n := []int64{5, 10, 20, 52, 7, 7}
+	k := []int64{2, 5, 10, 5, 0, 7}
+	y := []float64{10, 252, 184756, 2598960, 1, 1}
+	for i := range n {
+		x := exp(LogChoose(n[i], k[i]))
+		if !check(x, y[i]) {
+			t.Error()
+			fmt.Println(n[i], k[i], x, y[i])
+		}
+	}
+	if !isInf(LogChoose(5, 6), -1) || !isInf(LogChoose(5, -1), -1) {
+		t.Error()
+		fmt.Println("coefficient outside 0 <= k <= n is not zero")
+	}
+	// C(1000, 500) ~ 2.7e299, C(10000, 5000) overflows; stay on log scale
+	x := LogChoose(1000, 500)
+	y1 := 689.4672615678512
+	if abs(x-y1) > 1e-8 {
+		t.Error()
+		fmt.Println(x, y1)
+	}
+	x = LogChoose(10000, 5000)
+	if isInf(x, 0) || isNaN(x) || !isInf(exp(x), 1) {
+		t.Error()
+		fmt.Println(x)
+	}
+}
@@ -116,3 +116,36 @@ func TestGamma(t *testing.T) {
 		}
 	}
 }
+
+func TestGammaCDFLargeShape(t *testing.T) {
+	fmt.Println("test of Gamma CDF for large shape")
+	α := []float64{362, 362, 900, 900, 900}
+	x := []float64{362, 398.2, 810, 900, 990}
+	cdf := []float64{0.5069894201825085, 0.9685606590834114, 0.000983847566110245, 0.5044327192984899, 0.9982299609356836}
+	for i := range x {
+		prob := GammaCDFAt(α[i], 1, x[i])
+		if !check(prob, cdf[i]) {
+			t.Error()
+			fmt.Println(α[i], x[i], prob, cdf[i])
+		}
+	}
+}
+
+func TestGammaQtlLargeShape(t *testing.T) {
+	fmt.Println("test of Gamma Qtl for large shape")
+	α := []float64{362, 362, 900, 900, 900}
+	x := []float64{362, 398.2, 810, 900, 990}
+	cdf := []float64{0.5069894201825085, 0.9685606590834114, 0.000983847566110245, 0.5044327192984899, 0.9982299609356836}
+	for i := range x {
+		q := GammaQtlFor(α[i], 1, cdf[i])
+		if !check(q, x[i]) {
+			t.Error()
+			fmt.Println(α[i], cdf[i], q, x[i])
+		}
+		lnp := GammaLnCDFAt(α[i], 1, x[i])
+		if !check(exp(lnp), cdf[i]) {
+			t.Error()
+			fmt.Println(α[i], x[i], lnp, cdf[i])
+		}
+	}
+}
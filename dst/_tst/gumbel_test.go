@@ -0,0 +1,101 @@
+// test of Gumbel distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestGumbel(t *testing.T) {
+	fmt.Println("test of Gumbel distribution")
+	μ, β := 1.0, 2.0
+	if !check(GumbelCDFAt(μ, β, μ), math.Exp(-1)) || !check(GumbelPDFAt(μ, β, μ), math.Exp(-1)/β) {
+		t.Error()
+		fmt.Println(GumbelCDFAt(μ, β, μ), GumbelPDFAt(μ, β, μ))
+	}
+	// exp(-exp(-2)) and exp(-2 - exp(-2))/β at x = μ + 2β
+	if !check(GumbelCDFAt(μ, β, 5), 0.8734230184931167) || !check(GumbelPDFAt(μ, β, 5), 0.05910247579657157) ||
+		!check(math.Exp(GumbelLnPDF(μ, β)(5)), 0.05910247579657157) {
+		t.Error()
+		fmt.Println(GumbelCDFAt(μ, β, 5), GumbelPDFAt(μ, β, 5))
+	}
+	for _, x := range []float64{-4, -1, 0, 1, 2.5, 9, 20} {
+		p := GumbelCDFAt(μ, β, x)
+		if !check(GumbelQtlFor(μ, β, p)+10, x+10) {
+			t.Error()
+			fmt.Println(x, p, GumbelQtlFor(μ, β, p))
+		}
+	}
+	if !check(GumbelQtlFor(μ, β, 0.5), GumbelMedian(μ, β)) || !check(GumbelMean(μ, β), μ+β*0.5772156649015329) {
+		t.Error()
+		fmt.Println(GumbelMedian(μ, β), GumbelMean(μ, β))
+	}
+	for _, bad := range []float64{0, -1, math.NaN()} {
+		if !math.IsNaN(GumbelPDFAt(μ, bad, 1)) || !math.IsNaN(GumbelCDFAt(μ, bad, 1)) ||
+			!math.IsNaN(GumbelQtlFor(μ, bad, 0.5)) || !math.IsNaN(GumbelNext(μ, bad)) {
+			t.Error()
+			fmt.Println("failed: bad β accepted ", bad)
+		}
+	}
+
+	smp := NewSampler(13)
+	const n = 200000
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		x := smp.GumbelNext(μ, β)
+		sum += x
+		sumSq += x * x
+	}
+	m := sum / n
+	if math.Abs(m-GumbelMean(μ, β)) > 0.02 || math.Abs((sumSq/n-m*m)/GumbelVar(μ, β)-1) > 0.02 {
+		t.Error()
+		fmt.Println(m, GumbelMean(μ, β), sumSq/n-m*m, GumbelVar(μ, β))
+	}
+}
+
+// The maximum of k Exponential(1) draws, less log k, tends to Gumbel(0, 1).
+func TestGumbelMaxima(t *testing.T) {
+	fmt.Println("test of Gumbel distribution: maxima of Exponential samples")
+	xs := []float64{-1, 0, 0.5, 1, 2, 3}
+	// exact: P(max - log k <= x) = (1 - exp(-x)/k)^k, the distance to Gumbel shrinks as k grows
+	last := math.Inf(1)
+	for _, k := range []float64{2, 10, 100, 1000, 10000} {
+		d := 0.0
+		for _, x := range xs {
+			d = math.Max(d, math.Abs(math.Pow(1-math.Exp(-x)/k, k)-GumbelCDFAt(0, 1, x)))
+		}
+		if d >= last {
+			t.Error()
+			fmt.Println("failed: no convergence ", k, d, last)
+		}
+		last = d
+	}
+	if last > 1e-4 {
+		t.Error()
+		fmt.Println("failed: distance at k = 10000 ", last)
+	}
+
+	// simulated
+	const reps, k = 20000, 500
+	smp := NewSampler(17)
+	below := make([]int, len(xs))
+	for r := 0; r < reps; r++ {
+		mx := 0.0
+		for i := 0; i < k; i++ {
+			mx = math.Max(mx, smp.ExponentialNext(1))
+		}
+		mx -= math.Log(k)
+		for j, x := range xs {
+			if mx <= x {
+				below[j]++
+			}
+		}
+	}
+	for j, x := range xs {
+		if math.Abs(float64(below[j])/reps-GumbelCDFAt(0, 1, x)) > 0.012 {
+			t.Error()
+			fmt.Println(x, float64(below[j])/reps, GumbelCDFAt(0, 1, x))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// test of Half-Normal distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestHalfNormal(t *testing.T) {
+	fmt.Println("test of Half-Normal distribution")
+	for _, σ := range []float64{0.3, 1, 2.5} {
+		pdf := HalfNormalPDF(σ)
+		mass := simpson(pdf, 0, 30*σ, 20000)
+		mean := simpson(func(x float64) float64 { return x * pdf(x) }, 0, 30*σ, 20000)
+		if !check(mass, 1) || !check(mean, HalfNormalMean(σ)) || !check(HalfNormalMean(σ), σ*math.Sqrt(2/math.Pi)) {
+			t.Error()
+			fmt.Println(σ, mass, mean, HalfNormalMean(σ))
+		}
+		for _, x := range []float64{0.01, 0.5, 1, 3, 7} {
+			x *= σ
+			if !check(HalfNormalCDFAt(σ, x), 2*NormalCDFAt(0, σ, x)-1) || !check(HalfNormalCDFAt(σ, x), simpson(pdf, 0, x, 2000)) ||
+				!check(HalfNormalQtlFor(σ, HalfNormalCDFAt(σ, x)), x) ||
+				!check(HalfNormalPDFAt(σ, x), TruncNormalPDFAt(0, σ, 0, math.Inf(1), x)) ||
+				!check(math.Exp(HalfNormalLnPDF(σ)(x)), HalfNormalPDFAt(σ, x)) {
+				t.Error()
+				fmt.Println(σ, x, HalfNormalCDFAt(σ, x), 2*NormalCDFAt(0, σ, x)-1, HalfNormalQtlFor(σ, HalfNormalCDFAt(σ, x)))
+			}
+		}
+		if HalfNormalPDFAt(σ, -1) != 0 || HalfNormalCDFAt(σ, -1) != 0 || HalfNormalCDFAt(σ, 0) != 0 ||
+			!check(HalfNormalQtlFor(σ, 0.5), HalfNormalMedian(σ)) || !check(TruncNormalVar(0, σ, 0, math.Inf(1)), HalfNormalVar(σ)) {
+			t.Error()
+			fmt.Println(σ, HalfNormalQtlFor(σ, 0.5), HalfNormalMedian(σ))
+		}
+		// for small p, the quantile is p σ√(π/2) to first order
+		if q := HalfNormalQtlFor(σ, 1e-12); math.Abs(q/(1e-12*σ*math.Sqrt(math.Pi/2))-1) > 1e-14 ||
+			HalfNormalQtlFor(σ, 0) != 0 || !math.IsInf(HalfNormalQtlFor(σ, 1), 1) {
+			t.Error()
+			fmt.Println(σ, q, 1e-12*σ*math.Sqrt(math.Pi/2))
+		}
+	}
+
+	// draws are never negative, and their mean and variance converge to the theoretical ones
+	const n = 200000
+	σ := 1.7
+	smp := NewSampler(20130131)
+	sum, sum2 := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		x := smp.HalfNormalNext(σ)
+		if x < 0 {
+			t.Error()
+			fmt.Println("failed: negative sample ", x)
+			break
+		}
+		sum += x
+		sum2 += x * x
+	}
+	m := sum / n
+	v := sum2/n - m*m
+	if math.Abs(m-HalfNormalMean(σ)) > 4*HalfNormalStd(σ)/math.Sqrt(n) || math.Abs(v/HalfNormalVar(σ)-1) > 0.02 {
+		t.Error()
+		fmt.Println(m, HalfNormalMean(σ), v, HalfNormalVar(σ))
+	}
+
+	for _, bad := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+		if !math.IsNaN(HalfNormalPDFAt(bad, 1)) || !math.IsNaN(HalfNormalCDFAt(bad, 1)) ||
+			!math.IsNaN(HalfNormalQtlFor(bad, 0.5)) || !math.IsNaN(HalfNormalNext(bad)) {
+			t.Error()
+			fmt.Println("failed: bad σ accepted ", bad)
+		}
+	}
+}
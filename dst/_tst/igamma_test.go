@@ -3,7 +3,7 @@ package dst
 
 import (
 	"fmt"
-	//	"math/rand"
+	"math/rand"
 	"testing"
 )
 
@@ -46,3 +46,80 @@ func TestInvGamma(t *testing.T) {
 		fmt.Println(x, y)
 	}
 }
+
+func TestInvGammaQtlNext(t *testing.T) {
+	fmt.Println("test of InvGamma distribution: CDF(Qtl(p)) = p")
+	for _, α := range []float64{0.5, 2.2, 7.5} {
+		cdf := InvGammaCDF(α, 1.33)
+		qtl := InvGammaQtl(α, 1.33)
+		for _, p := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+			x := cdf(qtl(p))
+			if !check(x, p) {
+				t.Error()
+				fmt.Println(α, p, x)
+			}
+		}
+	}
+
+	fmt.Println("test of InvGamma distribution: Next, sample mean = β/(α-1)")
+	rand.Seed(1)
+	const n = 100000
+	for _, α := range []float64{3, 7.5} { // integer and non-integer shape take different paths in GammaNext
+		β := 1.33
+		sum := 0.0
+		for i := 0; i < n; i++ {
+			sum += InvGammaNext(α, β)
+		}
+		mean := InvGammaMean(α, β)
+		// 5 standard errors of the mean
+		if abs(sum/n-mean) > 5*InvGammaStd(α, β)/sqrt(n) {
+			t.Error()
+			fmt.Println(α, sum/n, mean)
+		}
+	}
+	if !isNaN(InvGammaNext(-1, 1)) {
+		t.Error("bad α accepted")
+	}
+}
+
+func TestInvGammaCDFLargeShape(t *testing.T) {
+	fmt.Println("test of InvGamma distribution: CDF for large shape")
+	// P(X <= x) = P(Gamma(α, 1) >= β/x); see TestGammaCDFLargeShape
+	α := []float64{362, 900}
+	x := []float64{1, 1}
+	β := []float64{362, 900}
+	cdf := []float64{1 - 0.5069894201825085, 1 - 0.5044327192984899}
+	for i := range x {
+		p := InvGammaCDFAt(α[i], β[i], x[i])
+		if !check(p, cdf[i]) {
+			t.Error()
+			fmt.Println(α[i], β[i], x[i], p, cdf[i])
+		}
+		if q := InvGammaQtlFor(α[i], β[i], cdf[i]); !check(q, x[i]) {
+			t.Error()
+			fmt.Println(α[i], β[i], cdf[i], q, x[i])
+		}
+	}
+}
+
+func TestInvGammaCDFLeftTail(t *testing.T) {
+	fmt.Println("test of InvGamma distribution: CDF far in the left tail")
+	// P(X <= x) = Q(α, β/x), the upper regularized incomplete gamma function;
+	// Q(n, y) = exp(-y) Σ_{k<n} y^k/k! for integer n, Q(1/2, y) = erfc(√y)
+	α := []float64{2, 3, 0.5, 0.5}
+	x := []float64{0.01, 0.02, 0.5, 2}
+	cdf := []float64{101 * exp(-100), 1301 * exp(-50), erfc(sqrt2), erfc(sqrt(0.5))}
+	for i := range x {
+		if p := InvGammaCDFAt(α[i], 1, x[i]); !check(p, cdf[i]) {
+			t.Error()
+			fmt.Println(α[i], x[i], p, cdf[i])
+		}
+	}
+	// near the mode, where neither tail is small
+	for _, a := range []float64{50, 400} {
+		if p, q := InvGammaCDFAt(a, a, 1), 1-GammaCDFAt(a, 1, a); !check(p, q) {
+			t.Error()
+			fmt.Println(a, p, q)
+		}
+	}
+}
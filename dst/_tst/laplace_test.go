@@ -0,0 +1,108 @@
+// test of Laplace distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLaplace(t *testing.T) {
+	fmt.Println("test of Laplace distribution: CDF")
+	μ, b := 1.5, 0.8
+	e2 := 0.5 * math.Exp(-2)
+	x := []float64{μ - 2*b, μ, μ + 2*b}
+	y := []float64{e2, 0.5, 1 - e2}
+	for i := range x {
+		if !check(LaplaceCDFAt(μ, b, x[i]), y[i]) {
+			t.Error()
+			fmt.Println(x[i], LaplaceCDFAt(μ, b, x[i]), y[i])
+		}
+	}
+	if !check(LaplacePDFAt(μ, b, μ+2*b), math.Exp(-2)/(2*b)) || !check(LaplaceLnPDF(μ, b)(μ-2*b), -2-math.Log(2*b)) {
+		t.Error()
+		fmt.Println("failed: PDF ", LaplacePDFAt(μ, b, μ+2*b))
+	}
+
+	fmt.Println("test of Laplace distribution: Qtl")
+	for _, xi := range []float64{-4, -0.3, 1.5, 2.2, 9} {
+		p := LaplaceCDFAt(μ, b, xi)
+		if !check(LaplaceQtlFor(μ, b, p), xi) {
+			t.Error()
+			fmt.Println(xi, p, LaplaceQtlFor(μ, b, p))
+		}
+	}
+	if LaplaceQtlFor(μ, b, 0) != math.Inf(-1) || LaplaceQtlFor(μ, b, 1) != math.Inf(1) {
+		t.Error()
+		fmt.Println("failed: quantiles at 0 and 1 ", LaplaceQtlFor(μ, b, 0), LaplaceQtlFor(μ, b, 1))
+	}
+	for _, bad := range []float64{0, -1, math.NaN()} {
+		if !math.IsNaN(LaplacePDFAt(μ, bad, 1)) || !math.IsNaN(LaplaceCDFAt(μ, bad, 1)) ||
+			!math.IsNaN(LaplaceQtlFor(μ, bad, 0.5)) || !math.IsNaN(LaplaceNext(μ, bad)) {
+			t.Error()
+			fmt.Println("failed: bad b accepted ", bad)
+		}
+	}
+	if !math.IsNaN(LaplaceQtlFor(μ, b, 1.5)) {
+		t.Error()
+		fmt.Println("failed: bad probability accepted")
+	}
+	if !check(LaplaceEntropy(b), 1+math.Log(2*b)) || !check(LaplaceVar(μ, b), 2*b*b) {
+		t.Error()
+		fmt.Println("failed: entropy or variance ", LaplaceEntropy(b), LaplaceVar(μ, b))
+	}
+}
+
+// laplaceMoments returns the mean, variance and excess kurtosis of x.
+func laplaceMoments(x []float64) (m, v, k float64) {
+	for _, xi := range x {
+		m += xi
+	}
+	m /= float64(len(x))
+	var m4 float64
+	for _, xi := range x {
+		d := (xi - m) * (xi - m)
+		v += d
+		m4 += d * d
+	}
+	v /= float64(len(x))
+	m4 /= float64(len(x))
+	return m, v, m4/(v*v) - 3
+}
+
+// A Normal with an Exponential variance of mean 2b² is Laplace(0, b); both ways must give the Laplace moments.
+func TestLaplaceMixture(t *testing.T) {
+	fmt.Println("test of Laplace distribution: Normal scale mixture")
+	const n = 400000
+	b := 1.7
+	smp := NewSampler(11)
+	mix := make([]float64, n)
+	lap := make([]float64, n)
+	for i := range mix {
+		mix[i] = smp.NormalNext(0, math.Sqrt(smp.GammaNext(1, 2*b*b)))
+		lap[i] = smp.LaplaceNext(0, b)
+	}
+	for _, x := range [][]float64{mix, lap} {
+		m, v, k := laplaceMoments(x)
+		if math.Abs(m) > 0.02 || math.Abs(v/LaplaceVar(0, b)-1) > 0.02 || math.Abs(k-LaplaceExKurt(0, b)) > 0.3 {
+			t.Error()
+			fmt.Println("failed: moments ", m, v, k)
+		}
+	}
+	// and both have the Laplace CDF at the quartiles
+	for _, x := range [][]float64{mix, lap} {
+		for _, p := range []float64{0.25, 0.5, 0.75} {
+			q := LaplaceQtlFor(0, b, p)
+			below := 0
+			for _, xi := range x {
+				if xi <= q {
+					below++
+				}
+			}
+			if math.Abs(float64(below)/n-p) > 0.005 {
+				t.Error()
+				fmt.Println("failed: share below quantile ", p, float64(below)/n)
+			}
+		}
+	}
+}
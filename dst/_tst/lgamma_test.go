@@ -0,0 +1,60 @@
+// test of log-Gamma function against extended precision values
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLgamma(t *testing.T) {
+	fmt.Println("test of Lgamma")
+	x := []float64{0.001, 0.5, 1, 2, 10, 170, 1e6}
+	y := []float64{
+		6.907178885383853,  // -log(0.001) - γ*0.001 + ...
+		0.5723649429247001, // log(sqrt(π))
+		0,
+		0,
+		12.801827480081469, // log(9!)
+		701.4372638087370,  // log(169!)
+		12815504.569147613, // log(999999!)
+	}
+	for i := range x {
+		lg := Lgamma(x[i])
+		if abs(lg-y[i]) > 1e-14*max(1, abs(y[i])) {
+			t.Error()
+			fmt.Println(x[i], lg, y[i])
+		}
+	}
+	// no overflow where Γ(x) itself overflows
+	if isInf(Lgamma(200), 0) || isInf(lgammafn(200), 0) {
+		t.Error()
+		fmt.Println("Lgamma(200) overflows")
+	}
+
+	fmt.Println("test of LgammaSign, negative arguments")
+	x = []float64{-0.5, -1.5, -2.5, -1 + 1e-8, -3 - 1e-6}
+	y = []float64{
+		1.2655121234846454,   // log(2 sqrt(π))
+		0.8600470153764810,   // log(4 sqrt(π)/3)
+		-0.05624371649767405, // log(8 sqrt(π)/15)
+		18.42068074315545,    // near the pole at -1
+		12.023749832480275,   // near the pole at -3
+	}
+	s := []int{-1, 1, -1, -1, 1}
+	for i := range x {
+		lg, sign := LgammaSign(x[i])
+		if abs(lg-y[i]) > 1e-12*max(1, abs(y[i])) || sign != s[i] {
+			t.Error()
+			fmt.Println(x[i], lg, sign, y[i], s[i])
+		}
+	}
+
+	fmt.Println("test of LgammaSign, poles")
+	for _, x := range []float64{0, -1, -2, -10} {
+		lg, _ := LgammaSign(x)
+		if !isInf(lg, 1) {
+			t.Error()
+			fmt.Println(x, lg)
+		}
+	}
+}
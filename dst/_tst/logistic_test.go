@@ -4,6 +4,7 @@ package dst
 import (
 	"fmt"
 	//	"math/rand"
+	"math"
 	"testing"
 )
 
@@ -54,3 +55,63 @@ func TestLogistic(t *testing.T) {
 		fmt.Println(x, y)
 	}
 }
+
+func TestLogisticCDFQtl(t *testing.T) {
+	fmt.Println("test of Logistic distribution: CDF against the integral of the PDF, Qtl against CDF")
+	μ, σ := -0.8, 1.7
+	pdf := LogisticPDF(μ, σ)
+	// Simpson's rule from far in the lower tail, where the mass below is exp(-40)
+	lo := μ - 40*σ
+	for _, x := range []float64{-6, -2, -0.8, 0, 1.5, 5} {
+		const m = 20000
+		h := (x - lo) / m
+		sum := pdf(lo) + pdf(x)
+		for i := 1; i < m; i++ {
+			w := 2.0
+			if i%2 == 1 {
+				w = 4
+			}
+			sum += w * pdf(lo+float64(i)*h)
+		}
+		if !check(sum*h/3, LogisticCDFAt(μ, σ, x)) {
+			t.Error()
+			fmt.Println(x, sum*h/3, LogisticCDFAt(μ, σ, x))
+		}
+	}
+	for _, p := range []float64{1e-10, 0.01, 0.25, 0.5, 0.8, 0.999} {
+		x := LogisticQtlFor(μ, σ, p)
+		if !check(LogisticCDFAt(μ, σ, x), p) {
+			t.Error()
+			fmt.Println(p, x, LogisticCDFAt(μ, σ, x))
+		}
+	}
+	if !math.IsNaN(LogisticQtlFor(μ, σ, 1.5)) || !math.IsNaN(LogisticCDFAt(μ, -1, 0)) {
+		t.Error()
+		fmt.Println("failed: bad arguments accepted")
+	}
+	// MGF: exp(μt) πσt/sin(πσt)
+	tt := 0.3
+	if !check(LogisticMGF(μ, σ, tt), math.Exp(μ*tt)*math.Pi*σ*tt/math.Sin(math.Pi*σ*tt)) || !math.IsInf(LogisticMGF(μ, σ, 1/σ), 1) {
+		t.Error()
+		fmt.Println(LogisticMGF(μ, σ, tt), LogisticMGF(μ, σ, 1/σ))
+	}
+}
+
+func TestLogisticNext(t *testing.T) {
+	fmt.Println("test of Logistic distribution: Next")
+	μ, σ := 2.0, 0.6
+	smp := NewSampler(23)
+	const n = 400000
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		x := smp.LogisticNext(μ, σ)
+		sum += x
+		sumSq += x * x
+	}
+	m := sum / n
+	v := sumSq/n - m*m
+	if math.Abs(m-μ) > 0.005 || math.Abs(v/LogisticVar(μ, σ)-1) > 0.01 {
+		t.Error()
+		fmt.Println(m, v, LogisticVar(μ, σ))
+	}
+}
@@ -36,3 +36,102 @@ func TestLogNormal(t *testing.T) {
 		fmt.Println(x, y)
 	}
 }
+
+// values of dlnorm, plnorm and qlnorm, from the closed forms
+func TestLogNormalValues(t *testing.T) {
+	fmt.Println("test of LogNormal distribution: values, integral and moments")
+	μ := []float64{0, 1.5, -0.7}
+	σ := []float64{1, 0.4, 2.2}
+	x := []float64{2.5, 3.1, 0.05}
+	pdf := []float64{0.10487106688964985, 0.21042496010270192, 2.1040729092154145}
+	cdf := []float64{0.8202427861042145, 0.17839609797527006, 0.14835499508643232}
+	p := []float64{0.3, 0.9, 0.02}
+	qtl := []float64{0.5919101006095542, 7.482901562677575, 0.0054168132191901724}
+	for i := range μ {
+		if !check(LogNormalPDFAt(μ[i], σ[i], x[i]), pdf[i]) || !check(LogNormalCDFAt(μ[i], σ[i], x[i]), cdf[i]) ||
+			!check(LogNormalQtlFor(μ[i], σ[i], p[i]), qtl[i]) {
+			t.Error()
+			fmt.Println(μ[i], σ[i], LogNormalPDFAt(μ[i], σ[i], x[i]), LogNormalCDFAt(μ[i], σ[i], x[i]), LogNormalQtlFor(μ[i], σ[i], p[i]))
+		}
+		if !check(LogNormalCDFAt(μ[i], σ[i], LogNormalQtlFor(μ[i], σ[i], p[i])), p[i]) {
+			t.Error()
+			fmt.Println("CDF(Qtl(p)) ", μ[i], σ[i], p[i])
+		}
+
+		// integrate over u = log(x): ∫ f(x) g(x) dx = ∫ f(e^u) g(e^u) e^u du
+		var m0, m1, m2, m3 float64
+		const steps = 200000
+		lo, hi := μ[i]-12*σ[i], μ[i]+12*σ[i]+3*σ[i]*σ[i]
+		h := (hi - lo) / steps
+		mean := LogNormalMean(μ[i], σ[i])
+		for j := 0; j < steps; j++ {
+			xj := exp(lo + (float64(j)+0.5)*h)
+			w := LogNormalPDFAt(μ[i], σ[i], xj) * xj * h
+			d := xj - mean
+			m0 += w
+			m1 += w * xj
+			m2 += w * d * d
+			m3 += w * d * d * d
+		}
+		if !check(m0, 1) || !check(m1, mean) || !check(m2, LogNormalVar(μ[i], σ[i])) ||
+			!check(m3/pow(m2, 1.5), LogNormalSkew(μ[i], σ[i])) {
+			t.Error()
+			fmt.Println(μ[i], σ[i], m0, m1, m2, m3/pow(m2, 1.5), LogNormalVar(μ[i], σ[i]), LogNormalSkew(μ[i], σ[i]))
+		}
+		if LogNormalMedian(μ[i], σ[i]) != exp(μ[i]) || !check(LogNormalMean(μ[i], σ[i]), exp(μ[i]+σ[i]*σ[i]/2)) {
+			t.Error()
+			fmt.Println("median or mean ", μ[i], σ[i])
+		}
+	}
+	if LogNormalPDFAt(0, 1, 0) != 0 || LogNormalPDFAt(0, 1, -1) != 0 || LogNormalCDFAt(0, 1, -1) != 0 {
+		t.Error()
+		fmt.Println("failed: nonzero outside the support")
+	}
+	if !isNaN(LogNormalPDFAt(0, 0, 1)) || !isNaN(LogNormalCDFAt(0, -1, 1)) || !isNaN(LogNormalQtlFor(0, 1, 1.5)) ||
+		!isNaN(LogNormalNext(0, -1)) {
+		t.Error()
+		fmt.Println("failed: bad parameters accepted")
+	}
+}
+
+// values as R's dlnorm, plnorm and qlnorm give them (computed from the closed forms), in the tails too
+func TestLogNormalR(t *testing.T) {
+	fmt.Println("test of LogNormal distribution: dlnorm, plnorm, qlnorm")
+	cases := []struct{ μ, σ, x, pdf, cdf, p, qtl float64 }{
+		{0.3, 0.25, 1.2, 1.1903513827490915, 0.31892257383926403, 0.75, 1.5977916187352645},
+		{-2, 1.8, 0.001, 5.3873048018651, 0.003200199813432003, 0.001, 0.0005196172105103939},
+		{3, 0.6, 100, 0.00018561157365208324, 0.9962666975383583, 0.999, 128.27025222895588},
+	}
+	for _, c := range cases {
+		if !check(LogNormalPDFAt(c.μ, c.σ, c.x), c.pdf) || !check(exp(LogNormalLnPDF(c.μ, c.σ)(c.x)), c.pdf) ||
+			!check(LogNormalCDFAt(c.μ, c.σ, c.x), c.cdf) || !check(LogNormalQtlFor(c.μ, c.σ, c.p), c.qtl) {
+			t.Error()
+			fmt.Println(c, LogNormalPDFAt(c.μ, c.σ, c.x), LogNormalCDFAt(c.μ, c.σ, c.x), LogNormalQtlFor(c.μ, c.σ, c.p))
+		}
+	}
+	if !isInf(LogNormalLnPDF(0, 1)(0), -1) {
+		t.Error()
+		fmt.Println("failed: LnPDF at 0 ", LogNormalLnPDF(0, 1)(0))
+	}
+
+	// the logs of the draws are Normal(μ, σ)
+	smp := NewSampler(19)
+	const n = 100000
+	μ, σ := 1.2, 0.7
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		x := smp.LogNormalNext(μ, σ)
+		if !(x > 0) {
+			t.Error()
+			fmt.Println("failed: draw outside the support ", x)
+			return
+		}
+		sum += log(x)
+		sumSq += log(x) * log(x)
+	}
+	m := sum / n
+	if abs(m-μ) > 0.01 || abs(sqrt(sumSq/n-m*m)-σ) > 0.01 {
+		t.Error()
+		fmt.Println(m, sqrt(sumSq/n-m*m))
+	}
+}
@@ -0,0 +1,99 @@
+// test of Pareto distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestPareto(t *testing.T) {
+	fmt.Println("test of Pareto distribution: PDF integrates to 1")
+	for _, c := range []struct{ θ, α float64 }{{1, 1}, {2.5, 0.5}, {0.1, 3}, {40, 1.7}} {
+		// Simpson's rule on u = log(x/θ), where PDF(x) dx = PDF(θ e^u) θ e^u du decays as exp(-αu)
+		pdf := ParetoPDF(c.θ, c.α)
+		const m = 20000
+		hi := 50 / c.α
+		h := hi / m
+		sum := 0.0
+		for i := 0; i <= m; i++ {
+			x := c.θ * math.Exp(float64(i)*h)
+			w := 2.0
+			switch {
+			case i == 0 || i == m:
+				w = 1
+			case i%2 == 1:
+				w = 4
+			}
+			sum += w * pdf(x) * x
+		}
+		if !check(sum*h/3, 1) {
+			t.Error()
+			fmt.Println(c, sum*h/3)
+		}
+		if ParetoPDFAt(c.θ, c.α, c.θ*0.99) != 0 || ParetoCDFAt(c.θ, c.α, c.θ*0.99) != 0 {
+			t.Error()
+			fmt.Println("failed: nonzero below θ ", c)
+		}
+	}
+
+	fmt.Println("test of Pareto distribution: Qtl")
+	θ, α := 2.0, 1.5
+	for _, p := range []float64{0, 0.01, 0.3, 0.5, 0.9, 0.999} {
+		x := ParetoQtlFor(θ, α, p)
+		if !check(ParetoCDFAt(θ, α, x)+1, p+1) || !check(x, θ*math.Pow(1-p, -1/α)) {
+			t.Error()
+			fmt.Println(p, x, ParetoCDFAt(θ, α, x))
+		}
+	}
+	if !check(ParetoQtlFor(θ, α, 0.5), ParetoMedian(θ, α)) || !check(math.Exp(ParetoLnPDF(θ, α)(3)), ParetoPDFAt(θ, α, 3)) {
+		t.Error()
+		fmt.Println(ParetoQtlFor(θ, α, 0.5), ParetoMedian(θ, α))
+	}
+	if !check(ParetoMean(θ, α), 6) || !math.IsInf(ParetoMean(θ, 1), 1) || !math.IsInf(ParetoVar(θ, α), 1) || !check(ParetoVar(1, 3), 0.75) {
+		t.Error()
+		fmt.Println(ParetoMean(θ, α), ParetoMean(θ, 1), ParetoVar(θ, α), ParetoVar(1, 3))
+	}
+	for _, bad := range []float64{0, -1, math.NaN()} {
+		if !math.IsNaN(ParetoPDFAt(bad, α, 3)) || !math.IsNaN(ParetoCDFAt(θ, bad, 3)) ||
+			!math.IsNaN(ParetoQtlFor(θ, bad, 0.5)) || !math.IsNaN(ParetoNext(bad, α)) {
+			t.Error()
+			fmt.Println("failed: bad parameter accepted ", bad)
+		}
+	}
+}
+
+// Pareto(1, 1) has no mean; the sample mean does not settle, but the sample median is near the median 2.
+func TestParetoNext(t *testing.T) {
+	fmt.Println("test of Pareto distribution: Next")
+	const n = 100001
+	smp := NewSampler(9)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = smp.ParetoNext(1, 1)
+		if x[i] < 1 {
+			t.Error()
+			fmt.Println("failed: draw below θ ", x[i])
+			return
+		}
+	}
+	sort.Float64s(x)
+	if math.Abs(x[n/2]-2) > 0.05 || math.Abs(x[n/10]-ParetoQtlFor(1, 1, 0.1)) > 0.01 {
+		t.Error()
+		fmt.Println(x[n/2], x[n/10])
+	}
+
+	// α = 4: mean and variance are finite
+	sum, sumSq := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		y := smp.ParetoNext(3, 4)
+		sum += y
+		sumSq += y * y
+	}
+	m := sum / n
+	if math.Abs(m/ParetoMean(3, 4)-1) > 0.01 || math.Abs((sumSq/n-m*m)/ParetoVar(3, 4)-1) > 0.1 {
+		t.Error()
+		fmt.Println(m, ParetoMean(3, 4), sumSq/n-m*m, ParetoVar(3, 4))
+	}
+}
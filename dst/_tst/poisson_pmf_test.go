@@ -0,0 +1,46 @@
+// test of Poisson PMF for large counts and rates
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPoissonPMFLarge(t *testing.T) {
+	fmt.Println("test of Poisson PMF: small and large counts")
+	cases := []struct {
+		λ   float64
+		k   int64
+		pmf float64
+	}{
+		{6, 0, 0.0024787522},
+		{6, 6, 0.160623141},
+		{6, 20, 3.72506194704295e-06},
+		{1000, 1000, 0.012614611348719664},     // exp(k log λ - λ - lgamma(k+1))
+		{4321.5, 5000, 5.530810735377087e-25},  // far in the upper tail
+		{1e6, 1000000, 0.00039894224715624404}, // exp(-1/12λ)/sqrt(2πλ), by Stirling
+		{2.5, 3, math.Exp(-1.54288727360559)},
+	}
+	for _, c := range cases {
+		p := PoissonPMFAt(c.λ, c.k)
+		if math.IsInf(p, 0) || p == 0 || !check(p, c.pmf) || !check(PoissonLnPMF(c.λ)(c.k), math.Log(c.pmf)) {
+			t.Error()
+			fmt.Println(c.λ, c.k, p, c.pmf)
+		}
+	}
+	if PoissonPMFAt(0, 0) != 1 || PoissonPMFAt(0, 3) != 0 || PoissonPMFAt(5, -1) != 0 || !math.IsNaN(PoissonPMFAt(-1, 2)) {
+		t.Error()
+		fmt.Println("failed: edge cases ", PoissonPMFAt(0, 0), PoissonPMFAt(0, 3), PoissonPMFAt(5, -1), PoissonPMFAt(-1, 2))
+	}
+	// the masses around a large rate add up to 1
+	sum := 0.0
+	pmf := PoissonPMF(2500)
+	for k := int64(2000); k <= 3000; k++ {
+		sum += pmf(k)
+	}
+	if !check(sum, 1) {
+		t.Error()
+		fmt.Println("failed: sum ", sum)
+	}
+}
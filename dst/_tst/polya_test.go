@@ -2,47 +2,47 @@ package dst
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
-/*
 // test against known values
 func TestPolyaPMFCDF(t *testing.T) {
 	var (
-	ρ, n float64
-	i int64
+		ρ, n float64
+		i    int64
 	)
 
 	// edit the following values:  >>>
-	ρ=0.5
-	n=20
+	ρ = 0.5
+	n = 20
 
-k:=[]int64{10, 11, 12, 16, 25, 40}
-pmf:=[]float64{0.0186544004827737808228, 0.025437818840146064758, 0.0328571826685220003128, 0.05907974191359244287, 0.04004139896255765052, 0.00121194851197753156874}
-cdf:=[]float64{0.0493685733526945114136, 0.074806392192840576172, 0.1076635748613625764847, 0.30885965851484797895, 0.81435098276449480181, 0.9968911986703366647292}
+	k := []int64{10, 11, 12, 16, 25, 40}
+	pmf := []float64{0.0186544004827737808228, 0.025437818840146064758, 0.0328571826685220003128, 0.05907974191359244287, 0.04004139896255765052, 0.00121194851197753156874}
+	cdf := []float64{0.0493685733526945114136, 0.074806392192840576172, 0.1076635748613625764847, 0.30885965851484797895, 0.81435098276449480181, 0.9968911986703366647292}
 
 	// <<<
 
 	fmt.Println("test of Polya PMF")
 	for i = 0; i < int64(len(k)); i++ {
-		prob:=PolyaPMFAt(ρ, n, k[i])
-			if !check(prob, pmf[i]){
-				t.Error()
-				fmt.Println(k[i], prob, pmf[i])
+		prob := PolyaPMFAt(ρ, n, k[i])
+		if !check(prob, pmf[i]) {
+			t.Error()
+			fmt.Println(k[i], prob, pmf[i])
 
-			}
+		}
 	}
 
 	fmt.Println("test of Polya CDF")
 	for i = 0; i < int64(len(k)); i++ {
-		prob:=PolyaCDFAt(ρ, n, k[i])
-			if !check(prob, cdf[i]){
-				t.Error()
-				fmt.Println(k[i], prob, cdf[i])
-			}
+		prob := PolyaCDFAt(ρ, n, k[i])
+		if !check(prob, cdf[i]) {
+			t.Error()
+			fmt.Println(k[i], prob, cdf[i])
+		}
 	}
 }
-*/
+
 func TestPolyaQtl(t *testing.T) {
 	var (
 		ρ, n float64
@@ -61,3 +61,40 @@ func TestPolyaQtl(t *testing.T) {
 		}
 	}
 }
+
+func TestPolyaLarge(t *testing.T) {
+	fmt.Println("test of Polya PMF for large k and r")
+	// exp(lgamma(k+r) - lgamma(k+1) - lgamma(r) + r*log(1-ρ) + k*log(ρ)), ρ = 0.9, r = 150.5, k = 1300
+	if !check(PolyaPMFAt(0.9, 150.5, 1300), 0.003183005303141284) {
+		t.Error()
+		fmt.Println(PolyaPMFAt(0.9, 150.5, 1300))
+	}
+	if PolyaPMFAt(0.5, 20, -1) != 0 || PolyaCDFAt(0.5, 20, -1) != 0 {
+		t.Error()
+		fmt.Println("failed: nonzero below the support")
+	}
+}
+
+func TestPolyaNext(t *testing.T) {
+	fmt.Println("test of Polya Next")
+	const n = 200000
+	ρ, r := 0.3, 2.5
+	smp := NewSampler(5)
+	var sum, sumSq float64
+	zeros := 0
+	for i := 0; i < n; i++ {
+		k := float64(smp.PolyaNext(ρ, r))
+		sum += k
+		sumSq += k * k
+		if k == 0 {
+			zeros++
+		}
+	}
+	m := sum / n
+	v := sumSq/n - m*m
+	if math.Abs(m/PolyaMean(ρ, r)-1) > 0.01 || math.Abs(v/PolyaVar(ρ, r)-1) > 0.03 ||
+		math.Abs(float64(zeros)/n-PolyaPMFAt(ρ, r, 0)) > 0.005 {
+		t.Error()
+		fmt.Println(m, PolyaMean(ρ, r), v, PolyaVar(ρ, r), float64(zeros)/n, PolyaPMFAt(ρ, r, 0))
+	}
+}
@@ -0,0 +1,62 @@
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestQuantileAuto(t *testing.T) {
+	fmt.Println("test for QuantileAuto")
+	// support far away from the initial bracket
+	cdf := NormalCDF(1e4, 3)
+	for _, p := range []float64{0.001, 0.3, 0.5, 0.975} {
+		x := QuantileAuto(cdf, p)
+		if y := NormalQtlFor(1e4, 3, p); !check(x, y) {
+			fmt.Println("failed: Normal ", p, x, y)
+			t.Error()
+		}
+	}
+	cdf = NormalCDF(-250, 0.01)
+	if x := QuantileAuto(cdf, 0.5); !check(x, -250) {
+		fmt.Println("failed: Normal ", x)
+		t.Error()
+	}
+
+	// support [0, ∞)
+	cdf = GammaCDF(3, 2)
+	for _, p := range []float64{0.01, 0.5, 0.99} {
+		x := QuantileAuto(cdf, p)
+		if !check(cdf(x), p) {
+			fmt.Println("failed: Gamma ", p, x, cdf(x))
+			t.Error()
+		}
+	}
+
+	if x := QuantileAuto(cdf, 1); !isNaN(x) {
+		fmt.Println("failed: expected NaN, got ", x)
+		t.Error()
+	}
+}
+
+func TestQuantileAutoNonFinite(t *testing.T) {
+	fmt.Println("test for QuantileAuto with non-finite input")
+	if x := QuantileAuto(NormalCDF(0, 1), NaN); !isNaN(x) {
+		fmt.Println("failed: p = NaN ", x)
+		t.Error()
+	}
+	if x := QuantileAuto(NormalCDF(NaN, 1), 0.5); !isNaN(x) {
+		fmt.Println("failed: cdf = NaN ", x)
+		t.Error()
+	}
+	// NaN only in part of the support, found during bisection
+	cdf := func(x float64) float64 {
+		if x > 0 && x < 1 {
+			return NaN
+		}
+		return NormalCDFAt(0, 1, x)
+	}
+	if x := QuantileAuto(cdf, 0.6); !isNaN(x) {
+		fmt.Println("failed: cdf partly NaN ", x)
+		t.Error()
+	}
+}
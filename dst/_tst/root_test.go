@@ -0,0 +1,74 @@
+// test of FindRoot on functions with known roots
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFindRoot(t *testing.T) {
+	fmt.Println("test of FindRoot: known roots, bad brackets")
+	tests := []struct {
+		name   string
+		f      func(float64) float64
+		lo, hi float64
+		root   float64
+	}{
+		{"x² - 2", func(x float64) float64 { return x*x - 2 }, 0, 2, math.Sqrt2},
+		{"cos x - x", func(x float64) float64 { return math.Cos(x) - x }, 0, 1, 0.7390851332151607},
+		{"(x - 1)³, flat at the root", func(x float64) float64 { return (x - 1) * (x - 1) * (x - 1) }, -3, 2, 1},
+		{"steep", func(x float64) float64 { return math.Exp(40*(x-0.5)) - 1 }, 0, 2, 0.5},
+		{"step", func(x float64) float64 { return math.Copysign(1, x-0.3) }, -1, 1, 0.3},
+		{"infinite at lo", math.Log, 0, 5, 1},
+		{"reversed bracket", func(x float64) float64 { return x*x - 2 }, 2, 0, math.Sqrt2},
+		{"large", func(x float64) float64 { return x - 1e12 }, 0, 3e12, 1e12},
+		{"root at hi", func(x float64) float64 { return x - 4 }, 1, 4, 4},
+	}
+	for _, tt := range tests {
+		evals := 0
+		f := func(x float64) float64 {
+			evals++
+			return tt.f(x)
+		}
+		x, err := FindRoot(f, tt.lo, tt.hi, 1e-12)
+		if err != nil || math.Abs(x-tt.root) > 1e-9*math.Max(1, tt.root) || evals > 150 {
+			t.Error()
+			fmt.Println(tt.name, x, tt.root, err, evals)
+		}
+	}
+	// the tolerance is honoured
+	x, err := FindRoot(func(x float64) float64 { return math.Cos(x) - x }, 0, 1, 1e-3)
+	if err != nil || math.Abs(x-0.7390851332151607) > 1e-3 {
+		t.Error()
+		fmt.Println(x, err)
+	}
+
+	// bad brackets fail at once
+	errs := []struct {
+		name   string
+		f      func(float64) float64
+		lo, hi float64
+		err    error
+	}{
+		{"same sign", func(x float64) float64 { return x*x + 1 }, -1, 1, ErrNoBracket},
+		{"even root", func(x float64) float64 { return x * x }, -1, 1, ErrNoBracket},
+		{"NaN at the end", math.Log, -1, 2, ErrRootNaN},
+		{"NaN inside", func(x float64) float64 {
+			if x > 0.2 && x < 0.8 {
+				return math.NaN()
+			}
+			return x - 0.5
+		}, 0, 1, ErrRootNaN},
+	}
+	for _, tt := range errs {
+		if _, err := FindRoot(tt.f, tt.lo, tt.hi, 1e-12); err != tt.err {
+			t.Error()
+			fmt.Println(tt.name, err)
+		}
+	}
+	if _, err := FindRoot(math.Sin, math.NaN(), 1, 1e-12); err == nil {
+		t.Error()
+		fmt.Println("no error for NaN bracket")
+	}
+}
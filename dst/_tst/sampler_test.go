@@ -0,0 +1,91 @@
+package dst
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// draws returns n draws of each distribution from s.
+func draws(s *Sampler, n int) []float64 {
+	x := make([]float64, 0, 6*n)
+	for i := 0; i < n; i++ {
+		x = append(x, s.Float64(), s.NormalNext(1, 2), s.ExponentialNext(3), s.GammaNext(2.5, 2),
+			s.BetaNext(2, 5), float64(s.PoissonNext(4.5)))
+	}
+	return x
+}
+
+func TestSamplerSeed(t *testing.T) {
+	fmt.Println("test of Sampler: reproducible draws")
+	x, y := draws(NewSampler(42), 100), draws(NewSampler(42), 100)
+	for i := range x {
+		if x[i] != y[i] {
+			t.Error()
+			fmt.Println("failed: different draws from the same seed ", i, x[i], y[i])
+			break
+		}
+	}
+
+	// parallel samplers, one per goroutine, give the draws of sequential ones
+	const workers = 4
+	par := make([][]float64, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			par[w] = draws(NewSampler(int64(w)), 1000)
+		}(w)
+	}
+	wg.Wait()
+	for w := 0; w < workers; w++ {
+		seq := draws(NewSampler(int64(w)), 1000)
+		for i := range seq {
+			if seq[i] != par[w][i] {
+				t.Error()
+				fmt.Println("failed: parallel draws differ ", w, i)
+				break
+			}
+		}
+	}
+}
+
+func TestSamplerMoments(t *testing.T) {
+	fmt.Println("test of Sampler: sample means")
+	s := NewSampler(7)
+	const n = 200000
+	var norm, ex, gam, bet, poi float64
+	for i := 0; i < n; i++ {
+		norm += s.NormalNext(1, 2)
+		ex += s.ExponentialNext(3)
+		gam += s.GammaNext(2.5, 2)
+		bet += s.BetaNext(2, 5)
+		poi += float64(s.PoissonNext(4.5))
+	}
+	// tolerances are about 5 standard errors
+	if abs(norm/n-1) > 0.025 || abs(ex/n-1./3) > 0.004 || abs(gam/n-5) > 0.04 || abs(bet/n-2./7) > 0.002 || abs(poi/n-4.5) > 0.025 {
+		t.Error()
+		fmt.Println(norm/n, ex/n, gam/n, bet/n, poi/n)
+	}
+
+	// the free functions draw from the global source, safely from several goroutines
+	var wg sync.WaitGroup
+	sums := make([]float64, 4)
+	for w := range sums {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 10000; i++ {
+				sums[w] += GammaNext(2.5, 2)
+			}
+		}(w)
+	}
+	wg.Wait()
+	for _, sum := range sums {
+		if abs(sum/10000-5) > 0.2 {
+			t.Error()
+			fmt.Println("failed: GammaNext mean ", sum/10000)
+		}
+	}
+}
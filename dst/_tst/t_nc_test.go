@@ -0,0 +1,34 @@
+// test of noncentral Student's t distribution against numerical integration
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNoncentralTCDF(t *testing.T) {
+	fmt.Println("test of noncentral Student's t distribution: CDF")
+	ν := []float64{10, 5.5, 20}
+	δ := []float64{1, 0.5, 2.5}
+	x := []float64{2, -1, 3}
+	y := []float64{0.80761156, 0.08107467, 0.66160287}
+	for i := range ν {
+		p := NoncentralTCDFAt(ν[i], δ[i], x[i])
+		if abs(p-y[i]) > 1e-6 {
+			t.Error()
+			fmt.Println(ν[i], δ[i], x[i], p, y[i])
+		}
+	}
+
+	fmt.Println("test of noncentral Student's t distribution: δ = 0 is central")
+	for _, x := range []float64{-2, 0.3, 1.7} {
+		if !check(NoncentralTCDFAt(7, 0, x), StudentsTCDFAt(7, x)) {
+			t.Error()
+			fmt.Println(x, NoncentralTCDFAt(7, 0, x), StudentsTCDFAt(7, x))
+		}
+		if abs(NoncentralTCDFAt(7, 1e-9, x)-StudentsTCDFAt(7, x)) > 1e-8 {
+			t.Error()
+			fmt.Println(x, NoncentralTCDFAt(7, 1e-9, x), StudentsTCDFAt(7, x))
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// test of Student's t distribution against t-tables and R:pt()
+package dst
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStudentsTCDF(t *testing.T) {
+	fmt.Println("test of Student's t distribution: CDF")
+	ν := []float64{1, 5, 30, 2}
+	x := []float64{1, 2.015048373, 2.042272456, 1}
+	y := []float64{0.75, 0.95, 0.975, 0.5 + 1/(2*sqrt(3))}
+	for i := range ν {
+		p := StudentsTCDFAt(ν[i], x[i])
+		if !check(p, y[i]) {
+			t.Error()
+			fmt.Println(ν[i], x[i], p, y[i])
+		}
+		// symmetry
+		p = StudentsTCDFAt(ν[i], -x[i])
+		if !check(p, 1-y[i]) {
+			t.Error()
+			fmt.Println(ν[i], -x[i], p, 1-y[i])
+		}
+	}
+
+	fmt.Println("test of Student's t distribution: CDF(Qtl(p)) = p")
+	for _, ν := range []float64{1, 1.5, 2.5, 5, 7.3, 30} {
+		cdf := StudentsTCDF(ν)
+		qtl := StudentsTQtl(ν)
+		for _, p := range []float64{0.01, 0.1, 0.5, 0.8, 0.975} {
+			x := cdf(qtl(p))
+			if !check(x, p) {
+				t.Error()
+				fmt.Println(ν, p, x)
+			}
+		}
+	}
+}
+
+func TestStudentsTPDF(t *testing.T) {
+	fmt.Println("test of Student's t distribution: PDF")
+	x := StudentsTPDFAt(3, 3)
+	y := 0.02297204 // R: dt(3, 3)
+	if abs(x-y) > 1e-8 {
+		t.Error()
+		fmt.Println(x, y)
+	}
+
+	fmt.Println("test of Student's t distribution: PDF integrates to 1")
+	for _, ν := range []float64{2.5, 5, 30} {
+		pdf := StudentsTPDF(ν)
+		// trapezoid rule on [-L, L], tail mass from the CDF
+		const L, n = 200.0, 400000
+		h := 2 * L / n
+		sum := (pdf(-L) + pdf(L)) / 2
+		for i := 1; i < n; i++ {
+			sum += pdf(-L + float64(i)*h)
+		}
+		x := sum*h + 2*StudentsTCDFAt(ν, -L)
+		if abs(x-1) > 1e-6 {
+			t.Error()
+			fmt.Println(ν, x)
+		}
+	}
+
+	fmt.Println("test of Student's t distribution: PDF, ν = 1 is Cauchy")
+	for _, x := range []float64{-3, -0.5, 0, 1.2, 10} {
+		if !check(StudentsTPDFAt(1, x), CauchyPDFAt(0, 1, x)) {
+			t.Error()
+			fmt.Println(x, StudentsTPDFAt(1, x), CauchyPDFAt(0, 1, x))
+		}
+	}
+
+	fmt.Println("test of Student's t distribution: PDF, large ν is Normal")
+	for _, x := range []float64{-3, -0.5, 0, 1.2, 2.5} {
+		p := StudentsTPDFAt(1e7, x)
+		if abs(p-ZPDFAt(x)) > 1e-6 {
+			t.Error()
+			fmt.Println(x, p, ZPDFAt(x))
+		}
+	}
+}
+
+func TestStudentsTQtl(t *testing.T) {
+	fmt.Println("test of Student's t distribution: Qtl against R:qt()")
+	ν := []float64{9, 24, 1, 2, 30}
+	p := []float64{0.975, 0.995, 0.975, 0.975, 0.95}
+	y := []float64{2.262157163, 2.796939505, 12.70620474, 4.302652730, 1.697260887}
+	for i := range ν {
+		x := StudentsTQtlFor(ν[i], p[i])
+		if !check(x, y[i]) {
+			t.Error()
+			fmt.Println(ν[i], p[i], x, y[i])
+		}
+		// symmetry
+		x = StudentsTQtlFor(ν[i], 1-p[i])
+		if !check(x, -y[i]) {
+			t.Error()
+			fmt.Println(ν[i], 1-p[i], x, -y[i])
+		}
+	}
+
+	fmt.Println("test of Student's t distribution: Qtl, extreme tails, closed form for ν = 2")
+	for _, p := range []float64{1e-20, 1e-100, 1e-250} {
+		x := StudentsTQtlFor(2, p)
+		y := (2*p - 1) / sqrt(2*p*(1-p))
+		if !check(x, y) {
+			t.Error()
+			fmt.Println(p, x, y)
+		}
+	}
+
+	fmt.Println("test of Student's t distribution: Qtl, fractional and small ν, extreme tails")
+	for _, ν := range []float64{0.3, 0.7, 1.5, 2.7, 5.3, 17.25, 1e6} {
+		cdf := StudentsTCDF(ν)
+		for _, p := range []float64{1e-20, 1e-8, 0.001, 0.3, 0.7, 0.999} {
+			x := StudentsTQtlFor(ν, p)
+			if isNaN(x) || !check(cdf(x), p) {
+				t.Error()
+				fmt.Println(ν, p, x, cdf(x))
+			}
+		}
+	}
+
+	fmt.Println("test of Student's t distribution: Qtl, large ν is Normal")
+	for _, p := range []float64{1e-10, 0.025, 0.9} {
+		x := StudentsTQtlFor(1e8, p)
+		if !check(x, ZQtlFor(p)) {
+			t.Error()
+			fmt.Println(p, x, ZQtlFor(p))
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// test of Truncated Normal distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// simpson integrates f over [a, b] by Simpson's rule with m (even) intervals.
+func simpson(f func(float64) float64, a, b float64, m int) float64 {
+	h := (b - a) / float64(m)
+	sum := f(a) + f(b)
+	for i := 1; i < m; i++ {
+		w := 2.0
+		if i%2 == 1 {
+			w = 4
+		}
+		sum += w * f(a+float64(i)*h)
+	}
+	return sum * h / 3
+}
+
+func TestTruncNormal(t *testing.T) {
+	fmt.Println("test of Truncated Normal distribution")
+	for _, c := range []struct{ μ, σ, lo, hi float64 }{
+		{0, 1, -1, 2},
+		{1, 2, 0, math.Inf(1)},   // a positive quantity
+		{0.3, 0.5, 0, 1},         // a probability
+		{0, 1, math.Inf(-1), -3}, // lower tail
+		{0, 1, 8, 9},             // far in the upper tail, where 1 - Φ(8) is lost to rounding
+	} {
+		// finite limits for the integration: the density beyond μ ± 30σ is negligible
+		a, b := math.Max(c.lo, c.μ-30*c.σ), math.Min(c.hi, c.μ+30*c.σ)
+		pdf := TruncNormalPDF(c.μ, c.σ, c.lo, c.hi)
+		mass := simpson(pdf, a, b, 20000)
+		mean := simpson(func(x float64) float64 { return x * pdf(x) }, a, b, 20000)
+		m2 := simpson(func(x float64) float64 { return x * x * pdf(x) }, a, b, 20000)
+		if !check(mass, 1) || !check(mean, TruncNormalMean(c.μ, c.σ, c.lo, c.hi)) ||
+			!check(m2-mean*mean, TruncNormalVar(c.μ, c.σ, c.lo, c.hi)) {
+			t.Error()
+			fmt.Println(c, mass, mean, TruncNormalMean(c.μ, c.σ, c.lo, c.hi), m2-mean*mean, TruncNormalVar(c.μ, c.σ, c.lo, c.hi))
+		}
+		if TruncNormalCDFAt(c.μ, c.σ, c.lo, c.hi, c.lo) != 0 || TruncNormalCDFAt(c.μ, c.σ, c.lo, c.hi, c.hi) != 1 ||
+			TruncNormalPDFAt(c.μ, c.σ, c.lo, c.hi, c.lo-1) != 0 || TruncNormalPDFAt(c.μ, c.σ, c.lo, c.hi, c.hi+1) != 0 {
+			t.Error()
+			fmt.Println("failed: limits ", c)
+		}
+		for _, p := range []float64{0.01, 0.25, 0.5, 0.9, 0.999} {
+			x := TruncNormalQtlFor(c.μ, c.σ, c.lo, c.hi, p)
+			if !(x >= c.lo && x <= c.hi) || !check(TruncNormalCDFAt(c.μ, c.σ, c.lo, c.hi, x), p) ||
+				!check(simpson(pdf, a, x, 20000), p) {
+				t.Error()
+				fmt.Println(c, p, x, TruncNormalCDFAt(c.μ, c.σ, c.lo, c.hi, x))
+			}
+		}
+		if !check(math.Exp(TruncNormalLnPDF(c.μ, c.σ, c.lo, c.hi)(TruncNormalMedian(c.μ, c.σ, c.lo, c.hi))),
+			pdf(TruncNormalMedian(c.μ, c.σ, c.lo, c.hi))) {
+			t.Error()
+			fmt.Println("failed: LnPDF ", c)
+		}
+	}
+
+	// without limits it is the Normal distribution
+	μ, σ := 1.5, 2.0
+	for _, x := range []float64{-3, 0, 1.5, 4} {
+		if !check(TruncNormalPDFAt(μ, σ, math.Inf(-1), math.Inf(1), x), NormalPDFAt(μ, σ, x)) ||
+			!check(TruncNormalCDFAt(μ, σ, math.Inf(-1), math.Inf(1), x), NormalCDFAt(μ, σ, x)) {
+			t.Error()
+			fmt.Println(x, TruncNormalPDFAt(μ, σ, math.Inf(-1), math.Inf(1), x), NormalPDFAt(μ, σ, x))
+		}
+	}
+	if !check(TruncNormalMean(μ, σ, math.Inf(-1), math.Inf(1)), μ) || !check(TruncNormalVar(μ, σ, math.Inf(-1), math.Inf(1)), σ*σ) {
+		t.Error()
+		fmt.Println(TruncNormalMean(μ, σ, math.Inf(-1), math.Inf(1)), TruncNormalVar(μ, σ, math.Inf(-1), math.Inf(1)))
+	}
+
+	// the half-normal: Normal(0, σ) truncated at 0 has mean σ√(2/π); samples are never negative
+	const n = 100000
+	smp := NewSampler(20130131)
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		x := smp.TruncNormalNext(0, σ, 0, math.Inf(1))
+		if x < 0 {
+			t.Error()
+			fmt.Println("failed: negative sample ", x)
+			break
+		}
+		sum += x
+	}
+	if want := σ * math.Sqrt(2/math.Pi); math.Abs(sum/n-want) > 4*σ/math.Sqrt(n) || !check(TruncNormalMean(0, σ, 0, math.Inf(1)), want) {
+		t.Error()
+		fmt.Println(sum/n, want, TruncNormalMean(0, σ, 0, math.Inf(1)))
+	}
+	if TruncNormalMode(5, 1, 0, 2) != 2 || TruncNormalMode(-5, 1, 0, 2) != 0 || TruncNormalMode(1, 1, 0, 2) != 1 {
+		t.Error()
+		fmt.Println("failed: mode")
+	}
+
+	for _, p := range [][4]float64{{0, 0, 0, 1}, {0, -1, 0, 1}, {0, 1, 1, 1}, {0, 1, 2, 1}, {math.NaN(), 1, 0, 1}, {0, 1, math.NaN(), 1}} {
+		if !math.IsNaN(TruncNormalPDFAt(p[0], p[1], p[2], p[3], 0.5)) || !math.IsNaN(TruncNormalCDFAt(p[0], p[1], p[2], p[3], 0.5)) ||
+			!math.IsNaN(TruncNormalQtlFor(p[0], p[1], p[2], p[3], 0.5)) || !math.IsNaN(TruncNormalNext(p[0], p[1], p[2], p[3])) {
+			t.Error()
+			fmt.Println("failed: bad parameters accepted ", p)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// test of Weibull distribution
+package dst
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// test against known values, as R's dweibull, pweibull and qweibull with shape 1.5 and scale 2
+// (computed from the closed forms)
+func TestWeibull(t *testing.T) {
+	fmt.Println("test of Weibull distribution")
+	k, λ := 1.5, 2.0
+	x := []float64{0.5, 1, 3}
+	pdf := []float64{0.3309363384692233, 0.37239168821942203, 0.14630426404454228}
+	cdf := []float64{0.1175030974154046, 0.2978114986734404, 0.8407240915099786}
+	for i := range x {
+		if !check(WeibullPDFAt(k, λ, x[i]), pdf[i]) || !check(WeibullCDFAt(k, λ, x[i]), cdf[i]) {
+			t.Error()
+			fmt.Println(x[i], WeibullPDFAt(k, λ, x[i]), pdf[i], WeibullCDFAt(k, λ, x[i]), cdf[i])
+		}
+		if !check(WeibullQtlFor(k, λ, cdf[i]), x[i]) || !check(math.Exp(WeibullLnPDF(k, λ)(x[i])), pdf[i]) {
+			t.Error()
+			fmt.Println(x[i], WeibullQtlFor(k, λ, cdf[i]))
+		}
+	}
+	p := []float64{0.1, 0.5, 0.9}
+	qtl := []float64{0.4461510512738342, 1.5664395375493028, 3.4874430271928234}
+	for i := range p {
+		if !check(WeibullQtlFor(k, λ, p[i]), qtl[i]) {
+			t.Error()
+			fmt.Println(p[i], WeibullQtlFor(k, λ, p[i]), qtl[i])
+		}
+	}
+	if !check(WeibullMean(k, λ), 1.8054905859018673) || !check(WeibullVar(k, λ), 1.502761139255727) ||
+		!check(WeibullMedian(k, λ), qtl[1]) {
+		t.Error()
+		fmt.Println(WeibullMean(k, λ), WeibullVar(k, λ), WeibullMedian(k, λ))
+	}
+	if WeibullPDFAt(k, λ, -1) != 0 || WeibullCDFAt(k, λ, -1) != 0 {
+		t.Error()
+		fmt.Println("failed: nonzero below the support")
+	}
+	for _, bad := range []float64{0, -1, math.NaN()} {
+		if !math.IsNaN(WeibullPDFAt(bad, λ, 1)) || !math.IsNaN(WeibullCDFAt(k, bad, 1)) ||
+			!math.IsNaN(WeibullQtlFor(bad, λ, 0.5)) || !math.IsNaN(WeibullNext(k, bad)) {
+			t.Error()
+			fmt.Println("failed: bad parameter accepted ", bad)
+		}
+	}
+}
+
+func TestWeibullSpecialCases(t *testing.T) {
+	fmt.Println("test of Weibull distribution: Exponential and Rayleigh cases")
+	λ := 1.6
+	for _, x := range []float64{0.2, 1, 4.5} {
+		// k = 1: Exponential with rate 1/λ, constant hazard
+		if !check(WeibullPDFAt(1, λ, x), ExponentialPDFAt(1/λ, x)) || !check(WeibullCDFAt(1, λ, x), ExponentialCDFAt(1/λ, x)) ||
+			!check(WeibullHazardAt(1, λ, x), 1/λ) {
+			t.Error()
+			fmt.Println("failed: exponential ", x, WeibullPDFAt(1, λ, x), ExponentialPDFAt(1/λ, x))
+		}
+		// k = 2: Rayleigh with σ = λ/√2, PDF x/σ² exp(-x²/2σ²), hazard x/σ²
+		σ := λ / math.Sqrt2
+		if !check(WeibullPDFAt(2, λ, x), x/(σ*σ)*math.Exp(-x*x/(2*σ*σ))) || !check(WeibullHazardAt(2, λ, x), x/(σ*σ)) {
+			t.Error()
+			fmt.Println("failed: Rayleigh ", x, WeibullPDFAt(2, λ, x))
+		}
+	}
+	if !check(WeibullMean(1, λ), λ) || !check(WeibullVar(1, λ), λ*λ) || !check(WeibullMean(2, λ), λ*math.Sqrt(math.Pi)/2) {
+		t.Error()
+		fmt.Println(WeibullMean(1, λ), WeibullVar(1, λ), WeibullMean(2, λ))
+	}
+}
+
+func TestWeibullHazard(t *testing.T) {
+	fmt.Println("test of Weibull distribution: hazard")
+	λ := 2.0
+	for _, k := range []float64{0.5, 0.8, 1.5, 3} {
+		h := WeibullHazard(k, λ)
+		last := h(0.01)
+		for x := 0.1; x < 10; x += 0.1 {
+			hx := h(x)
+			if (k > 1 && hx <= last) || (k < 1 && hx >= last) {
+				t.Error()
+				fmt.Println("failed: hazard not monotone ", k, x, hx, last)
+				break
+			}
+			last = hx
+			// hazard = PDF/(1 - CDF)
+			if x < 5 && !check(hx, WeibullPDFAt(k, λ, x)/(1-WeibullCDFAt(k, λ, x))) {
+				t.Error()
+				fmt.Println("failed: hazard ", k, x, hx)
+			}
+		}
+	}
+}
+
+func TestWeibullNext(t *testing.T) {
+	fmt.Println("test of Weibull distribution: Next")
+	const n = 200000
+	k, λ := 1.5, 2.0
+	smp := NewSampler(7)
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		x := smp.WeibullNext(k, λ)
+		sum += x
+		sumSq += x * x
+	}
+	m := sum / n
+	v := sumSq/n - m*m
+	if math.Abs(m/WeibullMean(k, λ)-1) > 0.01 || math.Abs(v/WeibullVar(k, λ)-1) > 0.02 {
+		t.Error()
+		fmt.Println(m, WeibullMean(k, λ), v, WeibullVar(k, λ))
+	}
+}
+
+func TestWeibullCDFFormula(t *testing.T) {
+	fmt.Println("test of Weibull distribution: CDF formula and parameter checks")
+	for _, k := range []float64{0.4, 1, 2.2} {
+		for _, λ := range []float64{0.5, 3} {
+			for _, x := range []float64{-2, 0, 0.1, 1, 4, 12} {
+				want := 0.0
+				if x >= 0 {
+					want = 1 - math.Exp(-math.Pow(x/λ, k))
+				}
+				if got := WeibullCDFAt(k, λ, x); math.Abs(got-want) > 1e-15 {
+					t.Error()
+					fmt.Println(k, λ, x, got, want)
+				}
+			}
+			// inverse-CDF sampling: λ(-log(1-u))^(1/k) has the Weibull quantiles
+			for _, u := range []float64{0.05, 0.5, 0.95} {
+				if !check(WeibullQtlFor(k, λ, u), λ*math.Pow(-math.Log(1-u), 1/k)) {
+					t.Error()
+					fmt.Println(k, λ, u, WeibullQtlFor(k, λ, u))
+				}
+			}
+		}
+	}
+	for _, p := range [][2]float64{{0, 1}, {1, 0}, {-1, 1}, {1, -2}, {math.Inf(1), 1}, {1, math.Inf(1)}, {math.NaN(), 1}} {
+		k, λ := p[0], p[1]
+		if !math.IsNaN(WeibullPDFAt(k, λ, 1)) || !math.IsNaN(WeibullCDFAt(k, λ, 1)) || !math.IsNaN(WeibullQtlFor(k, λ, 0.5)) ||
+			!math.IsNaN(WeibullHazardAt(k, λ, 1)) || !math.IsNaN(WeibullNext(k, λ)) {
+			t.Error()
+			fmt.Println("failed: bad parameters accepted ", k, λ)
+		}
+	}
+}
@@ -10,26 +10,9 @@ package dst
 
 import (
 	"fmt"
+	"math"
 )
 
-func bisect(x, p, a, b, xtol, ptol float64) float64 {
-	var x0, x1, px float64
-	cdf := BetaPDF(a, b)
-	for abs(x1-x0) > xtol {
-		px = cdf(x)
-		switch {
-		case abs(px-p) < ptol:
-			return x
-		case px < p:
-			x0 = x
-		case px > p:
-			x1 = x
-		}
-		x = 0.5 * (x0 + x1)
-	}
-	return x
-}
-
 func betaContinuedFraction(α, β, x float64) float64 {
 	var aa, del, res, qab, qap, qam, c, d, m2, m, acc float64
 	var i int64
@@ -84,6 +67,24 @@ func betaContinuedFraction(α, β, x float64) float64 {
 	return -1.00
 }
 
+// betaIncPair returns both the lower and upper regularized incomplete beta function at x, with y = 1 - x
+// supplied by the caller, so that neither tail is obtained by subtraction from one when it is the small one.
+func betaIncPair(α, β, x, y float64) (lower, upper float64) {
+	switch {
+	case x <= 0:
+		return 0, 1
+	case y <= 0:
+		return 1, 0
+	}
+	f := exp(LnΓ(α+β) - LnΓ(α) - LnΓ(β) + α*log(x) + β*log(y))
+	if x < (α+1.0)/(α+β+2.0) {
+		lower = f * betaContinuedFraction(α, β, x) / α
+		return lower, 1 - lower
+	}
+	upper = f * betaContinuedFraction(β, α, y) / β
+	return 1 - upper, upper
+}
+
 // BetaPDF returns the PDF of the Beta distribution. 
 func BetaPDF(α, β float64) func(x float64) float64 {
 	if α == 1 && β == 1 { // uniform case
@@ -100,6 +101,38 @@ func BetaPDF(α, β float64) func(x float64) float64 {
 	}
 }
 
+// BetaPDFSlice returns the values of the PDF of the Beta distribution at each x in xs.
+// The log normalizing constant -log B(α, β) is computed once for all points.
+func BetaPDFSlice(α, β float64, xs []float64) []float64 {
+	ys := make([]float64, len(xs))
+	if !(α > 0 && β > 0) || isInf(α, 0) || isInf(β, 0) {
+		for i := range ys {
+			ys[i] = NaN
+		}
+		return ys
+	}
+	c := -logB(α, β)
+	for i, x := range xs {
+		if isNaN(x) {
+			ys[i] = x
+			continue
+		}
+		if x < 0 || x > 1 {
+			ys[i] = 0
+			continue
+		}
+		l := c
+		if α != 1 { // avoid 0 * log(0) at the ends of the support
+			l += (α - 1) * log(x)
+		}
+		if β != 1 {
+			l += (β - 1) * log1p(-x)
+		}
+		ys[i] = exp(l)
+	}
+	return ys
+}
+
 // BetaLnPDF returns the natural logarithm of the PDF of the Beta distribution. 
 func BetaLnPDF(α, β float64) func(x float64) float64 {
 	dα := []float64{α, β}
@@ -149,35 +182,88 @@ func BetaCDFAt(α, β, x float64) float64 {
 }
 
 // BetaQtl returns the inverse of the CDF (quantile) of the Beta distribution. 
+// It returns NaN if the inverter does not converge within QtlMaxIter iterations.
 func BetaQtl(α, β float64) func(p float64) float64 {
 	// p: probability for which the quantile is evaluated
 	return func(p float64) float64 {
-		var x float64 = 0
-		var a float64 = 0
-		var b float64 = 1
-		var precision float64 = 1e-9
-		if p < 0.0 {
+		x, ok := BetaQtlConv(α, β, p)
+		if !ok {
 			return NaN
 		}
-		if p > 1.0 {
-			return NaN
+		return x
+	}
+}
+
+// BetaQtlConv returns the inverse of the CDF (quantile) of the Beta distribution, for given probability, 
+// and reports whether the inverter reached QtlTol within QtlMaxIter iterations.
+// The tolerance is relative to the distance from the nearer end of [0, 1], so that the quantiles of
+// U-shaped densities (α or β < 1), which may be very close to 0 or 1, are still found accurately.
+func BetaQtlConv(α, β, p float64) (x float64, ok bool) {
+	return BetaQtlConvOpts(α, β, p, defaultQtlOpts)
+}
+
+// BetaQtlConvOpts is BetaQtlConv with the tolerance and the iteration cap of opts.
+func BetaQtlConvOpts(α, β, p float64, opts QtlOpts) (x float64, ok bool) {
+	if !(p >= 0 && p <= 1) || !(α >= 0 && β >= 0) || isInf(α, 0) || isInf(β, 0) {
+		return NaN, false
+	}
+	switch {
+	case p == 0 || α == 0: // α == 0: all the mass is at 0 in the limit
+		return 0, true
+	case p == 1 || β == 0:
+		return 1, true
+	}
+	cdf := BetaCDF(α, β)
+	lnpdf := BetaLnPDF(α, β)
+
+	// Newton steps, kept inside the bracket [lo, hi] that holds the quantile;
+	// when a step leaves it, bisect instead.
+	lo, hi := 0.0, 1.0
+	x = α / (α + β)
+	for i := 0; ; i++ {
+		if i >= opts.MaxIter {
+			return x, false
 		}
-		if α < 0.0 {
-			return NaN
+		q := cdf(x)
+		if isNaN(q) {
+			return NaN, false
 		}
-		if β < 0.0 {
-			return NaN
+		if q == p {
+			return x, true
 		}
-
-		for (b - a) > precision {
-			x = (a + b) / 2
-			if iBr(α, β, x) > p {
-				b = x
-			} else {
-				a = x
+		if q < p {
+			lo = x
+		} else {
+			hi = x
+		}
+		nx := x - (q-p)/exp(lnpdf(x))
+		if !(nx > lo && nx < hi) {
+			// near the ends of [0, 1] bisect on the exponent, the quantile may be many orders of magnitude away;
+			// the square roots are taken apart, as lo*hi may underflow
+			switch {
+			case lo == 0:
+				nx = sqrt(min64) * sqrt(hi)
+			case hi == 1:
+				nx = 1 - (1-lo)*(1-lo)
+			case hi > 4*lo:
+				nx = sqrt(lo) * sqrt(hi)
+			case 1-lo > 4*(1-hi):
+				nx = 1 - sqrt((1-lo)*(1-hi))
+			default:
+				nx = lo + (hi-lo)/2
+			}
+			if !(nx > lo && nx < hi) {
+				nx = lo + (hi-lo)/2
+			}
+			if !(nx > lo && nx < hi) {
+				// no float64 left between lo and hi; below the least positive float64 the quantile is not found
+				return x, lo > 0 && hi == math.Nextafter(lo, hi)
 			}
 		}
-		return x
+		if abs(nx-x) <= opts.Tol*min(nx, 1-nx) {
+			return nx, true
+		}
+		x = nx
 	}
 }
 
@@ -189,11 +275,7 @@ func BetaQtlFor(α, β, p float64) float64 {
 
 // BetaNext returns random number drawn from the Beta distribution. 
 func BetaNext(α, β float64) float64 {
-	if α == 1 && β == 1 { // uniform case
-		return UniformNext(0, 1)
-	}
-	dα := []float64{α, β}
-	return DirichletNext(dα)[0]
+	return defaultSampler.BetaNext(α, β)
 }
 
 // Beta returns the random number generator with  Beta distribution. 
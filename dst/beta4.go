@@ -81,40 +81,16 @@ func Beta4CDFAt(α, β, a, c, x float64) float64 {
 	return cdf(x)
 }
 
-// Beta4Qtl returns the inverse of the CDF (quantile) of the four-parameter Beta distribution. 
+// Beta4Qtl returns the inverse of the CDF (quantile) of the four-parameter Beta distribution, 
+// the quantile of BetaQtl scaled to [a, c]. It returns NaN for a >= c, for bad α, β or p,
+// and where BetaQtl does not converge.
 func Beta4Qtl(α, β, a, c float64) func(p float64) float64 {
 	// p: probability for which the quantile is evaluated
 	return func(p float64) float64 {
-		var x float64 = 0
-		var a float64 = 0
-		var b float64 = 1
-		var precision float64 = 1e-9
 		if a >= c {
 			return NaN
 		}
-		if p < 0.0 {
-			return NaN
-		}
-		if p > 1.0 {
-			return NaN
-		}
-		if α < 0.0 {
-			return NaN
-		}
-		if β < 0.0 {
-			return NaN
-		}
-
-		for (b - a) > precision {
-			x = (a + b) / 2
-			if iBr(α, β, x) > p {
-				b = x
-			} else {
-				a = x
-			}
-		}
-
-		return x*(c-a) + a
+		return BetaQtlFor(α, β, p)*(c-a) + a
 	}
 }
 
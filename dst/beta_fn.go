@@ -0,0 +1,42 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Complete Beta function B(a, b) = Γ(a)Γ(b)/Γ(a+b), and its logarithm.
+// Computed in log space, with Stirling corrections for large arguments, as in R:lbeta().
+
+// LnBetaFn returns the natural logarithm of the Beta function.
+func LnBetaFn(a, b float64) float64 {
+	if isNaN(a) || isNaN(b) {
+		return a + b
+	}
+	p, q := a, b
+	if p > q {
+		p, q = q, p
+	}
+	if p < 0 {
+		return NaN
+	}
+	if p == 0 {
+		return posInf
+	}
+	if isInf(q, 1) {
+		return negInf
+	}
+	if p >= 10 {
+		// both large: log Γ(x) = (x-0.5)log(x) - x + log(sqrt(2π)) + stirlerr(x)
+		corr := stirlerr(p) + stirlerr(q) - stirlerr(p+q)
+		return -0.5*log(q) + M_LN_SQRT_2PI + corr + (p-0.5)*log(p/(p+q)) + q*log1p(-p/(p+q))
+	}
+	if q >= 10 {
+		// p small, q large
+		corr := stirlerr(q) - stirlerr(p+q)
+		return LnΓ(p) + corr + p - p*log(p+q) + (q-0.5)*log1p(-p/(p+q))
+	}
+	return LnΓ(p) + LnΓ(q) - LnΓ(p+q)
+}
+
+// BetaFn returns the Beta function.
+func BetaFn(a, b float64) float64 {
+	return exp(LnBetaFn(a, b))
+}
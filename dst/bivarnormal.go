@@ -0,0 +1,81 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Bivariate Normal distribution.
+// The joint distribution of two Normal variables with correlation ρ, written out for the 2×2 case
+// so that no matrix library is needed.
+//
+// Parameters:
+// μ1, μ2 ∈ R		means
+// σ1, σ2 > 0		standard deviations
+// ρ ∈ (-1, 1)		correlation coefficient
+//
+// Support:
+// (x1, x2) ∈ R²
+
+func bivarNormalBad(σ1, σ2, ρ float64) bool {
+	return !(σ1 > 0 && σ2 > 0 && ρ > -1 && ρ < 1)
+}
+
+// BivariateNormalPDF returns the PDF of the Bivariate Normal distribution.
+func BivariateNormalPDF(μ1, μ2, σ1, σ2, ρ float64) func(x1, x2 float64) float64 {
+	lnpdf := BivariateNormalLnPDF(μ1, μ2, σ1, σ2, ρ)
+	return func(x1, x2 float64) float64 {
+		return exp(lnpdf(x1, x2))
+	}
+}
+
+// BivariateNormalLnPDF returns the natural logarithm of the PDF of the Bivariate Normal distribution.
+func BivariateNormalLnPDF(μ1, μ2, σ1, σ2, ρ float64) func(x1, x2 float64) float64 {
+	if bivarNormalBad(σ1, σ2, ρ) {
+		return func(x1, x2 float64) float64 { return NaN }
+	}
+	r := 1 - ρ*ρ
+	lnNorm := -log(2*π*σ1*σ2) - 0.5*log(r)
+	return func(x1, x2 float64) float64 {
+		z1 := (x1 - μ1) / σ1
+		z2 := (x2 - μ2) / σ2
+		return lnNorm - (z1*z1-2*ρ*z1*z2+z2*z2)/(2*r)
+	}
+}
+
+// BivariateNormalPDFAt returns the value of PDF of Bivariate Normal distribution at (x1, x2).
+func BivariateNormalPDFAt(μ1, μ2, σ1, σ2, ρ, x1, x2 float64) float64 {
+	pdf := BivariateNormalPDF(μ1, μ2, σ1, σ2, ρ)
+	return pdf(x1, x2)
+}
+
+// BivariateNormalCond returns the mean and standard deviation of the conditional distribution of X1 given X2 = x2,
+// which is Normal. For X2 given X1, swap the parameters of the two components.
+func BivariateNormalCond(μ1, μ2, σ1, σ2, ρ, x2 float64) (μ, σ float64) {
+	if bivarNormalBad(σ1, σ2, ρ) {
+		return NaN, NaN
+	}
+	μ = μ1 + ρ*σ1/σ2*(x2-μ2)
+	σ = σ1 * sqrt(1-ρ*ρ)
+	return
+}
+
+// BivariateNormalNext returns random pair drawn from the Bivariate Normal distribution.
+func BivariateNormalNext(μ1, μ2, σ1, σ2, ρ float64) (x1, x2 float64) {
+	return defaultSampler.BivariateNormalNext(μ1, μ2, σ1, σ2, ρ)
+}
+
+// BivariateNormalNext returns random pair drawn from the Bivariate Normal distribution.
+// The pair is built from two independent standard normals with the Cholesky factor of the covariance matrix.
+func (smp *Sampler) BivariateNormalNext(μ1, μ2, σ1, σ2, ρ float64) (x1, x2 float64) {
+	if bivarNormalBad(σ1, σ2, ρ) {
+		return NaN, NaN
+	}
+	z1 := smp.rng.NormFloat64()
+	z2 := smp.rng.NormFloat64()
+	x1 = μ1 + σ1*z1
+	x2 = μ2 + σ2*(ρ*z1+sqrt(1-ρ*ρ)*z2)
+	return
+}
+
+// BivariateNormal returns the random pair generator with Bivariate Normal distribution.
+func BivariateNormal(μ1, μ2, σ1, σ2, ρ float64) func() (x1, x2 float64) {
+	return func() (x1, x2 float64) { return BivariateNormalNext(μ1, μ2, σ1, σ2, ρ) }
+}
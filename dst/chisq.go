@@ -13,7 +13,7 @@ func ChiSquarePDF(n int64) func(x float64) float64 {
 	k := float64(n) / 2
 	normalization := pow(0.5, k) / Γ(k)
 	return func(x float64) float64 {
-		return normalization * pow(x, k-1) * ExponentialNext(-x/2)
+		return normalization * pow(x, k-1) * exp(-x/2)
 	}
 }
 
@@ -53,6 +53,12 @@ func ChiSquareQtl(n int64) func(p float64) float64 {
 	}
 }
 
+// ChiSquareQtlFor returns the inverse of the CDF (quantile) of the ChiSquare distribution, for given probability.
+func ChiSquareQtlFor(n int64, p float64) float64 {
+	qtl := ChiSquareQtl(n)
+	return qtl(p)
+}
+
 // ChiSquareNext returns random number drawn from the ChiSquare distribution. 
 func ChiSquareNext(n int64) (x float64) {
 	//ChiSquare(n) => sum of n N(0,1)^2
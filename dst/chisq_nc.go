@@ -0,0 +1,77 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Noncentral ChiSquare distribution.
+// Distribution of the sum of squares of k independent Normal variables with unit variances and means μi, λ = Σ μi².
+//
+// Parameters:
+// k > 0	degrees of freedom (real)
+// λ >= 0	noncentrality parameter (real)
+//
+// Support:
+// x ∈ [0, ∞)
+
+// NoncentralChiSquareCDF returns the CDF of the noncentral ChiSquare distribution.
+// It returns NaN if the series does not converge.
+func NoncentralChiSquareCDF(k, λ float64) func(x float64) float64 {
+	// Poisson(λ/2) weighted mixture of central ChiSquare(k+2j) CDFs, summed outwards
+	// from the largest weight until the remaining weights fall below the accuracy target.
+	const (
+		errmax = 1e-14
+		itrmax = 100000
+	)
+	return func(x float64) float64 {
+		if k <= 0 || λ < 0 || isNaN(x) {
+			return NaN
+		}
+		if x <= 0 {
+			return 0
+		}
+		if isInf(x, 1) {
+			return 1
+		}
+		if λ == 0 {
+			return Γr(k/2, x/2)
+		}
+		h := λ / 2
+		m := floor(h)
+		wm := exp(-h + m*log(h) - LnΓ(m+1))
+
+		// forward: j = m, m+1, ...; the tail weight is bounded by a geometric series once j > h
+		sum := 0.0
+		w := wm
+		converged := false
+		for j := m; j < m+itrmax; j++ {
+			sum += w * Γr(k/2+j, x/2)
+			w *= h / (j + 1)
+			if j+1 > h && w*(j+2)/(j+2-h) < errmax {
+				converged = true
+				break
+			}
+		}
+		if !converged {
+			return NaN
+		}
+
+		// backward: j = m-1, ..., 0
+		w = wm
+		for j := m - 1; j >= 0; j-- {
+			w *= (j + 1) / h
+			sum += w * Γr(k/2+j, x/2)
+			if w < errmax*sum {
+				break
+			}
+		}
+		if sum > 1 {
+			sum = 1
+		}
+		return sum
+	}
+}
+
+// NoncentralChiSquareCDFAt returns the value of CDF of the noncentral ChiSquare distribution, at x.
+func NoncentralChiSquareCDFAt(k, λ, x float64) float64 {
+	cdf := NoncentralChiSquareCDF(k, λ)
+	return cdf(x)
+}
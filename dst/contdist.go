@@ -0,0 +1,153 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// A common interface for continuous distributions, with adapter types for the families used as conjugate priors,
+// so that a distribution can be passed around as one value instead of separate PDF, CDF and Qtl closures.
+// The adapters are named XxxDist, because Normal, Gamma and Beta already name the random number generators.
+// String gives the family and the named parameters, e.g. "Gamma(shape=3.5, rate=2)", with the shortest
+// representation that reads back to the same float64, and ParseDistribution reads it back.
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fmtParam formats a parameter value so that it reads back exactly.
+func fmtParam(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// ContDist is a continuous univariate distribution.
+type ContDist interface {
+	PDF(x float64) float64
+	CDF(x float64) float64
+	Qtl(p float64) float64
+	Rand() float64
+}
+
+// NormalDist is the Normal distribution with mean Mu and standard deviation Sigma.
+type NormalDist struct {
+	Mu, Sigma float64
+}
+
+func (d NormalDist) PDF(x float64) float64 { return NormalPDFAt(d.Mu, d.Sigma, x) }
+func (d NormalDist) CDF(x float64) float64 { return NormalCDFAt(d.Mu, d.Sigma, x) }
+func (d NormalDist) Qtl(p float64) float64 { return NormalQtlFor(d.Mu, d.Sigma, p) }
+func (d NormalDist) Rand() float64         { return NormalNext(d.Mu, d.Sigma) }
+func (d NormalDist) String() string {
+	return "Normal(μ=" + fmtParam(d.Mu) + ", σ=" + fmtParam(d.Sigma) + ")"
+}
+
+// GammaDist is the Gamma distribution with shape Shape and rate Rate (scale 1/Rate).
+type GammaDist struct {
+	Shape, Rate float64
+}
+
+func (d GammaDist) PDF(x float64) float64 { return GammaPDFAt(d.Shape, 1/d.Rate, x) }
+func (d GammaDist) CDF(x float64) float64 { return GammaCDFAt(d.Shape, 1/d.Rate, x) }
+func (d GammaDist) Qtl(p float64) float64 { return GammaQtlFor(d.Shape, 1/d.Rate, p) }
+func (d GammaDist) Rand() float64         { return GammaNext(d.Shape, 1/d.Rate) }
+func (d GammaDist) String() string {
+	return "Gamma(shape=" + fmtParam(d.Shape) + ", rate=" + fmtParam(d.Rate) + ")"
+}
+
+// BetaDist is the Beta distribution with shape parameters A and B.
+type BetaDist struct {
+	A, B float64
+}
+
+func (d BetaDist) PDF(x float64) float64 { return BetaPDFAt(d.A, d.B, x) }
+func (d BetaDist) CDF(x float64) float64 { return BetaCDFAt(d.A, d.B, x) }
+func (d BetaDist) Qtl(p float64) float64 { return BetaQtlFor(d.A, d.B, p) }
+func (d BetaDist) Rand() float64         { return BetaNext(d.A, d.B) }
+func (d BetaDist) String() string {
+	return "Beta(α=" + fmtParam(d.A) + ", β=" + fmtParam(d.B) + ")"
+}
+
+// contDistFamilies are the families known to ParseDistribution.
+var contDistFamilies = []struct {
+	name   string
+	params [][]string // accepted names of each parameter; String writes the first
+	rule   string     // parameter constraints, for the error message
+	ok     func(p []float64) bool
+	dist   func(p []float64) ContDist
+}{
+	{"Normal", [][]string{{"μ", "mu"}, {"σ", "sigma"}}, "σ > 0",
+		func(p []float64) bool { return p[1] > 0 },
+		func(p []float64) ContDist { return NormalDist{p[0], p[1]} }},
+	{"Gamma", [][]string{{"shape"}, {"rate"}}, "shape > 0 and rate > 0",
+		func(p []float64) bool { return p[0] > 0 && p[1] > 0 },
+		func(p []float64) ContDist { return GammaDist{p[0], p[1]} }},
+	{"Beta", [][]string{{"α", "alpha", "a"}, {"β", "beta", "b"}}, "α > 0 and β > 0",
+		func(p []float64) bool { return p[0] > 0 && p[1] > 0 },
+		func(p []float64) ContDist { return BetaDist{p[0], p[1]} }},
+}
+
+// ParseDistribution returns the distribution written as s, in the form given by String, e.g.
+// "Gamma(shape=3.5, rate=2)", or with positional parameters, e.g. "Gamma(3.5, 2)".
+// Named parameters may come in any order; mu, sigma, alpha and beta may be written for μ, σ, α and β.
+// The family name is not case sensitive, and white space around names and numbers is ignored.
+func ParseDistribution(s string) (ContDist, error) {
+	t := strings.TrimSpace(s)
+	open := strings.IndexByte(t, '(')
+	if open < 0 || !strings.HasSuffix(t, ")") {
+		return nil, fmt.Errorf("dst: %q is not of the form Family(p1, p2, ...)", s)
+	}
+	name := strings.TrimSpace(t[:open])
+	body := strings.TrimSpace(t[open+1 : len(t)-1])
+	var args []string
+	if body != "" {
+		args = strings.Split(body, ",")
+	}
+	for _, f := range contDistFamilies {
+		if !strings.EqualFold(name, f.name) {
+			continue
+		}
+		if len(args) != len(f.params) {
+			return nil, fmt.Errorf("dst: %s takes %d parameters, got %d", f.name, len(f.params), len(args))
+		}
+		p := make([]float64, len(f.params))
+		set := make([]bool, len(f.params))
+		for i, a := range args {
+			j := i
+			if eq := strings.IndexByte(a, '='); eq >= 0 {
+				j = paramIndex(f.params, strings.TrimSpace(a[:eq]))
+				if j < 0 {
+					return nil, fmt.Errorf("dst: %s has no parameter %q", f.name, strings.TrimSpace(a[:eq]))
+				}
+				a = a[eq+1:]
+			}
+			if set[j] {
+				return nil, fmt.Errorf("dst: %s parameter %s given twice", f.name, f.params[j][0])
+			}
+			x, err := strconv.ParseFloat(strings.TrimSpace(a), 64)
+			if err != nil || isNaN(x) || isInf(x, 0) {
+				return nil, fmt.Errorf("dst: %s parameter %s: %q is not a finite number", f.name, f.params[j][0], strings.TrimSpace(a))
+			}
+			p[j], set[j] = x, true
+		}
+		if !f.ok(p) {
+			return nil, fmt.Errorf("dst: %s parameters must satisfy %s", f.name, f.rule)
+		}
+		return f.dist(p), nil
+	}
+	if name == "" {
+		return nil, errors.New("dst: distribution family missing")
+	}
+	return nil, fmt.Errorf("dst: unknown distribution family %q", name)
+}
+
+// paramIndex returns the index of the parameter called name, or -1.
+func paramIndex(params [][]string, name string) int {
+	for j, names := range params {
+		for _, n := range names {
+			if n == name {
+				return j
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,24 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Convergence settings of the numerical inverters (BetaQtl, GammaQtl and the quantiles built on them).
+// Quantile functions return NaN if the inverter fails to converge; the XxxQtlConv variants
+// return the last iterate together with a flag instead, and the XxxQtlConvOpts variants take the settings per call.
+
+const (
+	// QtlTol is the default tolerance of the inverters: relative to the distance from the nearer end
+	// of [0, 1] for BetaQtl, and relative to the result for the final Newton steps of GammaQtl.
+	QtlTol = 1e-9
+	// QtlMaxIter is the default maximum number of iterations of each inverter.
+	QtlMaxIter = 1000
+)
+
+// QtlOpts are the convergence settings of one call of an inverter.
+type QtlOpts struct {
+	Tol     float64 // tolerance, as for QtlTol
+	MaxIter int     // maximum number of iterations
+}
+
+// defaultQtlOpts are the settings of the inverters that take none.
+var defaultQtlOpts = QtlOpts{Tol: QtlTol, MaxIter: QtlMaxIter}
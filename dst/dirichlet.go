@@ -9,29 +9,18 @@ package dst
 // θi ∈ [0, 1] and Σθi = 1
 
 // DirichletPDF returns the PDF of the Dirichlet distribution. 
+// It is computed in logs, so that Γ of large concentration parameters does not overflow.
 func DirichletPDF(α []float64) func(θ []float64) float64 {
+	lnpdf := DirichletLnPDF(α)
 	return func(θ []float64) float64 {
-		k := len(α)
-		if len(θ) != k {
-			return 0
-		}
-		l := float64(1.0)
-		totalα := float64(0)
-		for i := 0; i < k; i++ {
-			if θ[i] < 0 || θ[i] > 1 {
-				return 0
-			}
-			l *= pow(θ[i], α[i]-1)
-			l /= Γ(α[i])
-			totalα += α[i]
-		}
-		l *= Γ(totalα)
-		return l
+		return exp(lnpdf(θ))
 	}
 }
 
 // DirichletLnPDF returns the natural logarithm of the PDF of the Dirichlet distribution. 
+// It returns NaN if some αi is not greater than zero, and -Inf off the simplex.
 func DirichletLnPDF(α []float64) func(x []float64) float64 {
+	const tol = 1e-9 // for the sum of x
 	return func(x []float64) float64 {
 		k := len(α)
 		if len(x) != k {
@@ -39,13 +28,23 @@ func DirichletLnPDF(α []float64) func(x []float64) float64 {
 		}
 		l := fZero
 		totalα := float64(0)
+		totalx := float64(0)
 		for i := 0; i < k; i++ {
+			if !(α[i] > 0) || isInf(α[i], 0) {
+				return NaN
+			}
 			if x[i] < 0 || x[i] > 1 {
 				return negInf
 			}
-			l += (α[i] - 1) * log(x[i])
+			if α[i] != 1 { // avoid 0 * log(0) at the edges of the simplex
+				l += (α[i] - 1) * log(x[i])
+			}
 			l -= LnΓ(α[i])
 			totalα += α[i]
+			totalx += x[i]
+		}
+		if abs(totalx-1) > tol {
+			return negInf
 		}
 		l += LnΓ(totalα)
 		return l
@@ -60,14 +59,28 @@ func DirichletPDFAt(α, θ []float64) float64 {
 
 // DirichletNext returns random number drawn from the Dirichlet distribution. 
 func DirichletNext(α []float64) []float64 {
+	return defaultSampler.DirichletNext(α)
+}
+
+// DirichletNext returns random number drawn from the Dirichlet distribution, as independent Gamma(αi, 1) draws
+// divided by their sum. All elements are NaN if some αi is not greater than zero.
+func (smp *Sampler) DirichletNext(α []float64) []float64 {
 	k := len(α)
 	x := make([]float64, k)
+	for i := 0; i < k; i++ {
+		if !(α[i] > 0) || isInf(α[i], 1) {
+			for j := range x {
+				x[j] = NaN
+			}
+			return x
+		}
+	}
 	sum := fZero
-	for i := 0; i < len(α); i++ {
-		x[i] = GammaNext(α[i], 1.0)
+	for i := 0; i < k; i++ {
+		x[i] = smp.GammaNext(α[i], 1.0)
 		sum += x[i]
 	}
-	for i := 0; i < len(α); i++ {
+	for i := 0; i < k; i++ {
 		x[i] /= sum
 	}
 	return x
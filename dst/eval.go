@@ -0,0 +1,16 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Evaluation of a PDF, CDF or other function of one variable over a grid of points.
+// EvalAt works with any closure; the XxxPDFSlice functions of the individual distributions
+// compute the normalizing constant once for the whole grid instead of once per point.
+
+// EvalAt returns f(x) for each x in xs.
+func EvalAt(f func(float64) float64, xs []float64) []float64 {
+	ys := make([]float64, len(xs))
+	for i, x := range xs {
+		ys[i] = f(x)
+	}
+	return ys
+}
@@ -6,14 +6,18 @@ package dst
 // Parameters:
 // λ > 0: rate, or inverse scale
 // Support: x ∈ [0; ∞).
+// The functions return NaN for λ that is not positive and finite.
 
-import (
-	"math/rand"
-)
+func expBad(λ float64) bool {
+	return !(λ > 0) || isInf(λ, 0)
+}
 
 // ExponentialPDF returns the PDF of the Exponential distribution. 
 func ExponentialPDF(λ float64) func(x float64) float64 {
 	return func(x float64) float64 {
+		if expBad(λ) {
+			return NaN
+		}
 		if x < 0 {
 			return 0
 		}
@@ -24,6 +28,9 @@ func ExponentialPDF(λ float64) func(x float64) float64 {
 // ExponentialLnPDF returns the natural logarithm of the PDF of the Exponential distribution. 
 func ExponentialLnPDF(λ float64) func(x float64) float64 {
 	return func(x float64) float64 {
+		if expBad(λ) {
+			return NaN
+		}
 		if x < 0 {
 			return negInf
 		}
@@ -40,6 +47,9 @@ func ExponentialPDFAt(λ, x float64) float64 {
 // ExponentialCDF returns the CDF of the Exponential distribution. 
 func ExponentialCDF(λ float64) func(x float64) float64 {
 	return func(x float64) float64 {
+		if expBad(λ) {
+			return NaN
+		}
 		if x < 0 {
 			return 0
 		}
@@ -57,7 +67,10 @@ func ExponentialCDFAt(λ, x float64) float64 {
 func ExponentialQtl(λ float64) func(p float64) float64 {
 	// p: probability for which the quantile is evaluated
 	return func(p float64) float64 {
-		return -log(1-p) / λ
+		if expBad(λ) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		return -log1p(-p) / λ
 	}
 }
 
@@ -68,7 +81,9 @@ func ExponentialQtlFor(λ, p float64) float64 {
 }
 
 // ExponentialNext returns random number drawn from the Exponential distribution. 
-func ExponentialNext(λ float64) float64 { return rand.ExpFloat64() / λ }
+func ExponentialNext(λ float64) float64 {
+	return defaultSampler.ExponentialNext(λ)
+}
 
 // Exponential returns the random number generator with  Exponential distribution. 
 func Exponential(λ float64) func() float64 { return func() float64 { return ExponentialNext(λ) } }
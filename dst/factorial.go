@@ -0,0 +1,41 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Logarithms of the factorial and of the binomial coefficient, without overflow for large arguments.
+
+const nLogFactTable = 256
+
+// logFactTable holds log(n!) for n < nLogFactTable.
+var logFactTable = func() (t [nLogFactTable]float64) {
+	for i := range t {
+		t[i] = Lgamma(float64(i) + 1)
+	}
+	return
+}()
+
+// LogFactorial returns the natural logarithm of n!.
+func LogFactorial(n int64) float64 {
+	if n < 0 {
+		return NaN
+	}
+	if n < nLogFactTable {
+		return logFactTable[n]
+	}
+	return Lgamma(float64(n) + 1)
+}
+
+// LogChoose returns the natural logarithm of the binomial coefficient n over k.
+// It returns -Inf for k < 0 or k > n, where the coefficient is zero.
+func LogChoose(n, k int64) float64 {
+	if n < 0 {
+		return NaN
+	}
+	if k < 0 || k > n {
+		return negInf
+	}
+	if k == 0 || k == n {
+		return 0
+	}
+	return LogFactorial(n) - LogFactorial(k) - LogFactorial(n-k)
+}
@@ -7,9 +7,11 @@ import (
 
 const π = float64(math.Pi)
 const Ln2 = math.Ln2
+const EulerMascheroni = 0.57721566490153286060651209008240243
 const M_1_SQRT_2PI = 0.398942280401432677939946059934  // 1/sqrt(2pi)
 const M_LN_SQRT_2PI = 0.918938533204672741780329736406 // log(sqrt(2*pi))
 const min64 = math.SmallestNonzeroFloat64              //   DBL_MIN
+const max64 = math.MaxFloat64                          //   DBL_MAX
 const eps64 = 1.1102230246251565e-16                   // DBL_EPSILON   
 const maxExp = 1024.0                                  // DBL_MAX_EXP
 const sqrt2 = math.Sqrt2
@@ -38,22 +40,23 @@ var tan func(float64) float64 = math.Tan
 var trunc func(float64) float64 = math.Trunc
 var erf func(float64) float64 = math.Erf
 var erfc func(float64) float64 = math.Erfc
+var erfinv func(float64) float64 = math.Erfinv
 var isNaN func(float64) bool = math.IsNaN
 var isInf func(float64, int) bool = math.IsInf
 
 // Functions imported from "github.com/datastream/go-fn/fn".
 var Γ func(float64) float64 = fn.Γ
-var LnΓ func(float64) float64 = fn.LnΓ
+var LnΓ func(float64) float64 = Lgamma
 var Γr func(float64, float64) float64 = fn.Γr
 var iΓ func(float64, float64) float64 = fn.IΓ
-var B func(float64, float64) float64 = fn.B
-var logB func(float64, float64) float64 = fn.LnB
+var B func(float64, float64) float64 = BetaFn
+var logB func(float64, float64) float64 = LnBetaFn
 var iBr func(float64, float64, float64) float64 = fn.BetaIncReg
 var BinomCoeff func(int64, int64) float64 = fn.BinomCoeff
 var logBinomCoeff func(float64, float64) float64 = fn.LnBinomCoeff
 var Γpr func(int, float64, float64) float64 = fn.GammaPRatio
 var logΓpr func(int, float64, float64) float64 = fn.LnGammaPRatio
-var logChoose func(int64, int64) float64 = fn.LnChoose
+var logChoose func(int64, int64) float64 = LogChoose
 var ζ func(float64) float64 = fn.RiemannZeta
 var iΓint func(int64, float64) float64 = fn.IΓint
 var fact func(int64) float64 = fn.Fact
@@ -30,7 +30,7 @@ func GammaPDF(α float64, θ float64) func(x float64) float64 {
 			return NaN
 		}
 		if x < 0 {
-			return negInf
+			return 0
 		}
 		if α == 0 {
 			//	return (x == 0)? ML_POSINF : R_D__0;
@@ -60,6 +60,36 @@ func GammaPDF(α float64, θ float64) func(x float64) float64 {
 	}
 }
 
+// GammaPDFSlice returns the values of the PDF of the Gamma distribution at each x in xs.
+// The log normalizing constant -lnΓ(α) - α log θ is computed once for all points. For shape α > 1e5, where
+// it cancels against (α-1) log x with loss of precision, the points are passed to GammaPDF one by one.
+func GammaPDFSlice(α, θ float64, xs []float64) []float64 {
+	if α > 1e5 || α == 0 || isNaN(α) || isNaN(θ) {
+		return EvalAt(GammaPDF(α, θ), xs)
+	}
+	ys := make([]float64, len(xs))
+	if α < 0 || θ <= 0 {
+		for i := range ys {
+			ys[i] = NaN
+		}
+		return ys
+	}
+	c := -LnΓ(α) - α*log(θ)
+	for i, x := range xs {
+		switch {
+		case isNaN(x):
+			ys[i] = x
+		case x < 0:
+			ys[i] = 0
+		case α == 1:
+			ys[i] = exp(-x/θ) / θ
+		default:
+			ys[i] = exp(c + (α-1)*log(x) - x/θ)
+		}
+	}
+	return ys
+}
+
 // GammaPDF2 returns the PDF of the Gamma distribution. Another tested implementation.
 func GammaPDF2(α, θ float64) func(x float64) float64 {
 	return func(x float64) float64 {
@@ -205,45 +235,7 @@ func GammaLnCDFAt(α, θ, x float64) float64 {
 
 // GammaNext returns random number drawn from the Gamma distribution. 
 func GammaNext(α float64, θ float64) float64 {
-	//if α is a small integer, this way is faster on my laptop
-	if α == float64(int64(α)) && α <= 15 {
-		x := ExponentialNext(θ)
-		for i := 1; i < int(α); i++ {
-			x += ExponentialNext(θ)
-		}
-		return x
-	}
-
-	if α < 0.75 {
-		return RejectionSample(GammaPDF(α, θ), ExponentialPDF(θ), Exponential(θ), 1)
-	}
-
-	//Tadikamalla ACM '73
-	a := α - 1
-	b := 0.5 + 0.5*sqrt(4*α-3)
-	c := a * (1 + b) / b
-	d := (b - 1) / (a * b)
-	s := a / b
-	p := 1.0 / (2 - exp(-s))
-	var x, y float64
-	for i := 1; ; i++ {
-		u := UniformNext(0, 1)
-		if u > p {
-			var e float64
-			for e = -log((1 - u) / (1 - p)); e > s; e = e - a/b {
-			}
-			x = a - b*e
-			y = a - x
-		} else {
-			x = a - b*log(u/p)
-			y = x - a
-		}
-		u2 := UniformNext(0, 1)
-		if log(u2) <= a*log(d*x)-x+y/b+c {
-			break
-		}
-	}
-	return x / θ
+	return defaultSampler.GammaNext(α, θ)
 }
 
 // Gamma returns the random number generator with  Gamma distribution. 
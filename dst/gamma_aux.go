@@ -78,9 +78,9 @@ func log1pmx(x float64) float64 {
 	return r * (2*y*logcf(y, 3, 2, tol_logcf) - x)
 }
 
-// Ln(Abs(Gamma()))
+// Ln(Abs(Gamma())), without overflow for large a
 func lgammafn(a float64) float64 {
-	return log(abs(Γ(a)))
+	return Lgamma(a)
 }
 
 // Compute  log(gamma(a+1))  accurately also for small a (0 < a < 0.5).
@@ -205,6 +205,26 @@ func pgamma_smallx(x, shape float64) float64 {
 	return f1 * f2
 }
 
+// pgamma_smallx_upper is the upper tail of pgamma_smallx, 1 - (1+sum)·x^shape/Γ(shape+1), without cancellation.
+func pgamma_smallx_upper(x, shape float64) float64 {
+	var term float64
+	sum := 0.0
+	c := shape
+	n := 0.0
+
+	term = 1e32 // just to enter the while loop
+	for abs(term) > eps64*abs(sum) {
+		n++
+		c *= -x / n
+		term = c / (shape + n)
+		sum += term
+	}
+
+	f1m1 := sum
+	f2m1 := expm1(shape*log(x) - lgamma1p(shape))
+	return -(f1m1 + f2m1 + f1m1*f2m1)
+}
+
 func pd_upper_series(x, y float64) float64 {
 	term := x / y
 	sum := term
@@ -337,7 +357,7 @@ func pd_lower_series(lambda, y float64) float64 {
 //	   pnorm (x, 0, 1, lower_tail, FALSE)
 //
 // Abramowitz & Stegun 26.2.12
-func dpnorm(x, lp float64) float64 {
+func dpnorm(x float64, lower_tail bool, lp float64) float64 {
 	// So as not to repeat a pnorm call, we expect
 	//
 	//	 lp == pnorm (x, 0, 1, lower_tail, TRUE)
@@ -345,7 +365,6 @@ func dpnorm(x, lp float64) float64 {
 	// but use it only in the non-critical case where either x is small
 	// or p==exp(lp) is close to 1.
 
-	lower_tail := true
 	if x < 0 {
 		x = -x
 		lower_tail = !lower_tail
@@ -376,7 +395,7 @@ func dpnorm(x, lp float64) float64 {
 // has value <= x.
 // Various assertions about this are made (without proof) at
 // http://members.aol.com/iandjmsmith/PoissonApprox.htm
-func ppois_asymp(lambda, x float64, log_p bool) float64 {
+func ppois_asymp(x, lambda float64, lower_tail, log_p bool) float64 {
 	var coefs_a = [8]float64{
 		-1e9, // placeholder used for 1-indexing
 		2 / 3.0,
@@ -405,7 +424,6 @@ func ppois_asymp(lambda, x float64, log_p bool) float64 {
 		dfm, pt_, s2pt, f, np                         float64
 	)
 
-	lower_tail := true
 	dfm = lambda - x
 
 	// If lambda is large, the distribution is highly concentrated
@@ -439,17 +457,22 @@ func ppois_asymp(lambda, x float64, log_p bool) float64 {
 		elfb_term /= x
 	}
 
-	if !lower_tail { // should never happen
+	if !lower_tail {
 		elfb = -elfb
 	}
 
 	f = res12 / elfb
-	np = ZCDFAt(s2pt)
+	//	np = pnorm(s2pt, 0.0, 1.0, !lower_tail, log_p)
+	if lower_tail {
+		np = ZCDFAt(-s2pt)
+	} else {
+		np = ZCDFAt(s2pt)
+	}
 
 	if log_p {
-		//	n_d_over_p := dpnorm(s2pt, !lower_tail, np)
-		n_d_over_p := dpnorm(s2pt, np)
-		return np + log1p(f*n_d_over_p)
+		lnp := log(np)
+		n_d_over_p := dpnorm(s2pt, !lower_tail, lnp)
+		return lnp + log1p(f*n_d_over_p)
 	} else {
 		nd := ZPDFAt(s2pt)
 
@@ -464,14 +487,13 @@ func dpois_wrap(x_plus_1, lambda float64) float64 {
 	}
 
 	if x_plus_1 > 1 {
-		//		return dpois_raw(x_plus_1-1, lambda, give_log)
-		return PoissonPMFAt(lambda, int64(x_plus_1-1))
+		return dpois_raw(x_plus_1-1, lambda)
 	}
 
 	if lambda > abs(x_plus_1-1)*M_cutoff {
 		return exp(-lambda - lgammafn(x_plus_1))
 	}
-	d := PoissonPMFAt(lambda, int64(x_plus_1))
+	d := dpois_raw(x_plus_1, lambda)
 	return d * (x_plus_1 / lambda)
 }
 
@@ -543,18 +565,58 @@ func pgamma_raw(x, shape float64) float64 {
 		}
 		res = 1 - d*sum
 	} else { // x >= 1 and x fairly near shape.
-		res = ppois_asymp(shape-1, x, false)
+		res = ppois_asymp(shape-1, x, false, false)
 	}
 
 	// We lose a fair amount of accuracy to underflow in the cases
 	// where the final result is very close to min64.	
 	//  In those cases, simply redo via logarithm.
 	if res < min64/eps64 {
-		return exp(pgamma_raw_ln(shape, x))
+		return exp(pgamma_raw_ln(x, shape))
 	}
 	return res
 }
 
+// pgamma_raw_upper returns the upper tail 1 - pgamma_raw(x, shape), computed directly, so that it keeps its
+// relative precision where the lower tail is close to 1.
+func pgamma_raw_upper(x, shape float64) float64 {
+	// Here, assume that  (x,shape) are not NA  &  shape > 0 .
+
+	var sum float64
+
+	if x <= 0 {
+		return 1
+	}
+	if x >= posInf {
+		return 0
+	}
+
+	if x < 1 {
+		return pgamma_smallx_upper(x, shape)
+	} else if x <= shape-1 && x < 0.8*(shape+50) {
+		// incl. large shape compared to x
+		sum = pd_upper_series(x, shape) /* = x/shape + o(x/shape) */
+		d := dpois_wrap(shape, x)
+		return 1 - d*sum
+	} else if shape-1 < x && shape < 0.8*(x+50) {
+		// incl. large x compared to shape
+		d := dpois_wrap(shape, x)
+		if shape < 1 {
+			if x*eps64 > 1-shape {
+				sum = 1
+			} else {
+				sum = pd_lower_cf(shape, x-(shape-1)) * x / shape
+			}
+		} else {
+			sum = pd_lower_series(x, shape-1) // = (shape-1)/x + o((shape-1)/x)
+			sum = 1 + sum
+		}
+		return d * sum
+	}
+	// x >= 1 and x fairly near shape.
+	return ppois_asymp(shape-1, x, true, false)
+}
+
 func qchisq_appr(p, nu, g float64, lower_tail, log_p bool, tol float64) float64 {
 	// g  = log Gamma(nu/2)
 
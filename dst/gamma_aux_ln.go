@@ -52,7 +52,7 @@ func pgamma_raw_ln(x, shape float64) float64 {
 		res = log1Exp(d + sum)
 
 	} else { /* x >= 1 and x fairly near shape. */
-		res = ppois_asymp(shape-1, x, true)
+		res = ppois_asymp(shape-1, x, false, true)
 	}
 	return res
 }
@@ -12,7 +12,32 @@ package dst
 // x ∈ (0, ∞)
 
 // GammaQtl returns the inverse of the CDF (quantile) of the Gamma distribution. 
+// It returns NaN if neither AS 91 nor the final Newton steps converge.
 func GammaQtl(alpha, scale float64) func(p float64) float64 {
+	qtl := gammaQtl(alpha, scale, defaultQtlOpts)
+	return func(p float64) float64 {
+		x, ok := qtl(p)
+		if !ok {
+			return NaN
+		}
+		return x
+	}
+}
+
+// GammaQtlConv returns the inverse of the CDF (quantile) of the Gamma distribution, for given probability, 
+// and reports whether it converged: AS 91 within QtlMaxIter iterations, or else the final Newton steps,
+// the last of them within QtlTol of the result, relative to it.
+func GammaQtlConv(alpha, scale, p float64) (float64, bool) {
+	return GammaQtlConvOpts(alpha, scale, p, defaultQtlOpts)
+}
+
+// GammaQtlConvOpts is GammaQtlConv with the tolerance and the iteration cap of opts.
+func GammaQtlConvOpts(alpha, scale, p float64, opts QtlOpts) (float64, bool) {
+	qtl := gammaQtl(alpha, scale, opts)
+	return qtl(p)
+}
+
+func gammaQtl(alpha, scale float64, opts QtlOpts) func(p float64) (float64, bool) {
 	/*	This function is based on the Applied Statistics
 	 *	Algorithm AS 91 ("ppchi2") and via pgamma(.) AS 239.
 	 *
@@ -28,7 +53,7 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 	 *	Applied Statistics 24, page 385.  
 	 */
 
-	return func(p float64) float64 {
+	return func(p float64) (float64, bool) {
 
 		lower_tail := true // to be removed
 		log_p := false
@@ -39,8 +64,6 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 			EPS_N  = 1e-15               /* precision of Newton step / iterations */
 			LN_EPS = -36.043653389117156 /* = log(.Machine$float64.eps) iff IEEE_754 */
 
-			MAXIT = 1000
-
 			pMIN = 1e-100      /* was 0.000002 = 2e-6 */
 			pMAX = (1 - 1e-14) /* was (1-1e-12) and 0.999998 = 1 - 2e-6 */
 
@@ -52,30 +75,34 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 			p_, a, b, c, g, ch, ch0, p1         float64
 			p2, q, s1, s2, s3, s4, s5, s6, t, x float64
 			i, max_it_Newton                    int
+			conv                                = true
 		)
 		/* test arguments and initialise */
 
 		if isNaN(p) || isNaN(alpha) || isNaN(scale) {
-			return p + alpha + scale
+			return p + alpha + scale, false
+		}
+		if isInf(alpha, 0) || isInf(scale, 0) {
+			return NaN, false
 		}
 		//    R_Q_P01_boundaries(p, 0., ML_POSINF)
 		if p < 0 || p > 1 {
-			return NaN
+			return NaN, false
 		}
 		if p == 0 {
 
-			return 0
+			return 0, true
 		}
 		if p == 1 {
-			return posInf
+			return posInf, true
 		}
 
 		if alpha < 0 || scale <= 0 {
-			return NaN
+			return NaN, false
 		}
 
 		if alpha == 0 { // all mass at 0
-			return 0
+			return 0, true
 		}
 
 		max_it_Newton = 1
@@ -115,7 +142,7 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 		s6 = (120 + c*(346+127*c)) * i5040 /* used below, is "const" */
 
 		ch0 = ch /* save initial approx. */
-		for i = 1; i <= MAXIT; i++ {
+		for i = 1; i <= opts.MaxIter; i++ {
 			q = ch
 			p1 = 0.5 * ch
 			//	p2 = p_ - pgamma_raw(p1, alpha, /*lower_tail*/TRUE, /*log_p*/FALSE)
@@ -149,7 +176,8 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 			}
 		}
 
-		/* no convergence in MAXIT iterations -- but we add Newton now... */
+		/* no convergence in opts.MaxIter iterations -- but we add Newton now... */
+		conv = false
 
 	END:
 		/* PR# 2214 :	 From: Morten Welinder <terra@diku.dk>, Fri, 25 Oct 2002 16:50
@@ -176,18 +204,19 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 
 				//	    if(( lower_tail && p_ > p * _1_p) || (!lower_tail && p_ < p * _1_m))
 				if lower_tail && p_ > p*_1_p {
-					return 0
+					return 0, true
 				}
 			} else { // continue, using x = min64 instead of  0
 				//	    p_ = pgamma(x, alpha, scale, lower_tail, log_p)
 				p_ = GammaLnCDFAt(alpha, scale, x)
 			}
 			if p_ == negInf {
-				return 0 /* PR#14710 */
+				return 0, true /* PR#14710 */
 			}
 			for i = 1; i <= max_it_Newton; i++ {
 				p1 = p_ - p
 				if abs(p1) < abs(EPS_N*p) {
+					conv = true
 					break
 				}
 				/* else */
@@ -214,7 +243,7 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 				t = x - t
 
 				//	    p_ = pgamma (t, alpha, scale, lower_tail, log_p)
-				p_ = GammaLnCDFAt(alpha, scale, x)
+				p_ = GammaLnCDFAt(alpha, scale, t)
 				if abs(p_-p) > abs(p1) || (i > 1 && abs(p_-p) == abs(p1)) { // <- against flip-flop
 					// no improvement
 					break
@@ -228,10 +257,17 @@ func GammaQtl(alpha, scale float64) func(p float64) float64 {
 					t = 0.9 * x
 				}
 				//endif
+				// without AS 91 convergence, a Newton step within opts.Tol of x will do
+				if abs(t-x) <= opts.Tol*x {
+					conv = true
+				}
 				x = t
 			}
 		}
-		return x
+		if isNaN(x) || isInf(x, 0) {
+			return NaN, false
+		}
+		return x, conv
 	}
 }
 
@@ -0,0 +1,137 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Gumbel (Type I Extreme Value) distribution.
+// The limit distribution of the maximum of many draws from a population with exponential tails, e.g. the
+// annual maximum flow of a river in flood frequency analysis: the maximum of n Exponential(1) draws, less log n,
+// tends to Gumbel(0, 1).
+//
+// Parameters:
+// μ ∈ R		location
+// β > 0		scale
+//
+// Support:
+// x ∈ R
+
+func gumbelBad(μ, β float64) bool {
+	return isNaN(μ) || isInf(μ, 0) || !(β > 0) || isInf(β, 1)
+}
+
+// GumbelPDF returns the PDF of the Gumbel distribution.
+func GumbelPDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if gumbelBad(μ, β) || isNaN(x) {
+			return NaN
+		}
+		z := (x - μ) / β
+		return exp(-z-exp(-z)) / β
+	}
+}
+
+// GumbelLnPDF returns the natural logarithm of the PDF of the Gumbel distribution.
+func GumbelLnPDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if gumbelBad(μ, β) || isNaN(x) {
+			return NaN
+		}
+		z := (x - μ) / β
+		return -z - exp(-z) - log(β)
+	}
+}
+
+// GumbelPDFAt returns the value of PDF of Gumbel distribution at x.
+func GumbelPDFAt(μ, β, x float64) float64 {
+	pdf := GumbelPDF(μ, β)
+	return pdf(x)
+}
+
+// GumbelCDF returns the CDF of the Gumbel distribution.
+func GumbelCDF(μ, β float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if gumbelBad(μ, β) || isNaN(x) {
+			return NaN
+		}
+		return exp(-exp(-(x - μ) / β))
+	}
+}
+
+// GumbelCDFAt returns the value of CDF of the Gumbel distribution, at x.
+func GumbelCDFAt(μ, β, x float64) float64 {
+	cdf := GumbelCDF(μ, β)
+	return cdf(x)
+}
+
+// GumbelQtl returns the inverse of the CDF (quantile) of the Gumbel distribution.
+func GumbelQtl(μ, β float64) func(p float64) float64 {
+	return func(p float64) float64 {
+		if gumbelBad(μ, β) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		return μ - β*log(-log(p))
+	}
+}
+
+// GumbelQtlFor returns the inverse of the CDF (quantile) of the Gumbel distribution, for given probability.
+func GumbelQtlFor(μ, β, p float64) float64 {
+	qtl := GumbelQtl(μ, β)
+	return qtl(p)
+}
+
+// GumbelNext returns random number drawn from the Gumbel distribution.
+func GumbelNext(μ, β float64) float64 {
+	return defaultSampler.GumbelNext(μ, β)
+}
+
+// GumbelNext returns random number drawn from the Gumbel distribution, as μ - β log E of an Exponential(1) E.
+func (smp *Sampler) GumbelNext(μ, β float64) float64 {
+	if gumbelBad(μ, β) {
+		return NaN
+	}
+	return μ - β*log(smp.rng.ExpFloat64())
+}
+
+// Gumbel returns the random number generator with Gumbel distribution.
+func Gumbel(μ, β float64) func() float64 {
+	return func() float64 { return GumbelNext(μ, β) }
+}
+
+// GumbelMean returns the mean of the Gumbel distribution.
+func GumbelMean(μ, β float64) float64 {
+	return μ + β*EulerMascheroni
+}
+
+// GumbelMedian returns the median of the Gumbel distribution.
+func GumbelMedian(μ, β float64) float64 {
+	return μ - β*log(Ln2)
+}
+
+// GumbelMode returns the mode of the Gumbel distribution.
+func GumbelMode(μ, β float64) float64 {
+	return μ
+}
+
+// GumbelVar returns the variance of the Gumbel distribution.
+func GumbelVar(μ, β float64) float64 {
+	return π * π * β * β / 6
+}
+
+// GumbelStd returns the standard deviation of the Gumbel distribution.
+func GumbelStd(μ, β float64) float64 {
+	return π * β / sqrt(6)
+}
+
+// GumbelSkew returns the skewness 12√6 ζ(3)/π³ of the Gumbel distribution.
+func GumbelSkew(μ, β float64) float64 {
+	return 1.1395470994046488
+}
+
+// GumbelExKurt returns the excess kurtosis of the Gumbel distribution.
+func GumbelExKurt(μ, β float64) float64 {
+	return 12.0 / 5.0
+}
+
+// GumbelEntropy returns the entropy of the Gumbel distribution, in nats.
+func GumbelEntropy(μ, β float64) float64 {
+	return log(β) + EulerMascheroni + 1
+}
@@ -0,0 +1,144 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Half-Normal distribution.
+// The distribution of |X| for X ~ Normal(0, σ); a weakly informative prior for a scale parameter, e.g. the standard
+// deviation of a hierarchical model, that puts most mass below a few σ but does not rule out larger values.
+// It is the Truncated Normal distribution with μ = 0, lo = 0, hi = +Inf.
+//
+// Parameters:
+// σ > 0		scale (the standard deviation of the untruncated Normal)
+//
+// Support:
+// x ∈ [0, ∞)
+
+func halfNormalBad(σ float64) bool {
+	return !(σ > 0) || isInf(σ, 1)
+}
+
+// HalfNormalPDF returns the PDF of the Half-Normal distribution.
+func HalfNormalPDF(σ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if halfNormalBad(σ) || isNaN(x) {
+			return NaN
+		}
+		if x < 0 {
+			return 0
+		}
+		return 2 * NormalPDFAt(0, σ, x)
+	}
+}
+
+// HalfNormalLnPDF returns the natural logarithm of the PDF of the Half-Normal distribution.
+func HalfNormalLnPDF(σ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if halfNormalBad(σ) || isNaN(x) {
+			return NaN
+		}
+		if x < 0 {
+			return negInf
+		}
+		return Ln2 + NormalLnPDF(0, σ)(x)
+	}
+}
+
+// HalfNormalPDFAt returns the value of PDF of Half-Normal distribution at x.
+func HalfNormalPDFAt(σ, x float64) float64 {
+	pdf := HalfNormalPDF(σ)
+	return pdf(x)
+}
+
+// HalfNormalCDF returns the CDF of the Half-Normal distribution, 2Φ(x/σ) - 1 = erf(x/(σ√2)) for x ≥ 0.
+func HalfNormalCDF(σ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if halfNormalBad(σ) || isNaN(x) {
+			return NaN
+		}
+		if x <= 0 {
+			return 0
+		}
+		return erf(x / (σ * sqrt2))
+	}
+}
+
+// HalfNormalCDFAt returns the value of CDF of the Half-Normal distribution, at x.
+func HalfNormalCDFAt(σ, x float64) float64 {
+	cdf := HalfNormalCDF(σ)
+	return cdf(x)
+}
+
+// HalfNormalQtl returns the inverse of the CDF (quantile) of the Half-Normal distribution, σ√2 erf⁻¹(p).
+// This equals σ Φ⁻¹((1+p)/2), but keeps full precision for small p, where 1+p would round.
+func HalfNormalQtl(σ float64) func(p float64) float64 {
+	return func(p float64) float64 {
+		if halfNormalBad(σ) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		return σ * sqrt2 * erfinv(p)
+	}
+}
+
+// HalfNormalQtlFor returns the inverse of the CDF (quantile) of the Half-Normal distribution, for given probability.
+func HalfNormalQtlFor(σ, p float64) float64 {
+	qtl := HalfNormalQtl(σ)
+	return qtl(p)
+}
+
+// HalfNormalNext returns random number drawn from the Half-Normal distribution.
+func HalfNormalNext(σ float64) float64 {
+	return defaultSampler.HalfNormalNext(σ)
+}
+
+// HalfNormalNext returns random number drawn from the Half-Normal distribution, as |X| of a Normal(0, σ) draw X.
+func (smp *Sampler) HalfNormalNext(σ float64) float64 {
+	if halfNormalBad(σ) {
+		return NaN
+	}
+	return abs(smp.NormalNext(0, σ))
+}
+
+// HalfNormal returns the random number generator with Half-Normal distribution.
+func HalfNormal(σ float64) func() float64 {
+	return func() float64 { return HalfNormalNext(σ) }
+}
+
+// HalfNormalMean returns the mean σ√(2/π) of the Half-Normal distribution.
+func HalfNormalMean(σ float64) float64 {
+	return σ * sqrt(2/π)
+}
+
+// HalfNormalMedian returns the median σ Φ⁻¹(3/4) of the Half-Normal distribution.
+func HalfNormalMedian(σ float64) float64 {
+	return σ * 0.6744897501960817
+}
+
+// HalfNormalMode returns the mode of the Half-Normal distribution.
+func HalfNormalMode(σ float64) float64 {
+	return 0
+}
+
+// HalfNormalVar returns the variance σ²(1 - 2/π) of the Half-Normal distribution.
+func HalfNormalVar(σ float64) float64 {
+	return σ * σ * (1 - 2/π)
+}
+
+// HalfNormalStd returns the standard deviation of the Half-Normal distribution.
+func HalfNormalStd(σ float64) float64 {
+	return σ * sqrt(1-2/π)
+}
+
+// HalfNormalSkew returns the skewness √2(4-π)/(π-2)^(3/2) of the Half-Normal distribution.
+func HalfNormalSkew(σ float64) float64 {
+	return sqrt2 * (4 - π) / pow(π-2, 1.5)
+}
+
+// HalfNormalExKurt returns the excess kurtosis 8(π-3)/(π-2)² of the Half-Normal distribution.
+func HalfNormalExKurt(σ float64) float64 {
+	return 8 * (π - 3) / ((π - 2) * (π - 2))
+}
+
+// HalfNormalEntropy returns the entropy of the Half-Normal distribution, in nats.
+func HalfNormalEntropy(σ float64) float64 {
+	return log(π*σ*σ/2)/2 + 0.5
+}
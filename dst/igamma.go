@@ -70,7 +70,8 @@ func InvGammaCDF(α, β float64) func(x float64) float64 {
 		if x <= 0 {
 			return 0
 		}
-		return iΓ(α, β/x) / Γ(α)
+		// upper tail of Gamma(α, 1) at β/x; iΓ(α, β/x) / Γ(α) overflows for α > 171
+		return pgamma_raw_upper(β/x, α)
 	}
 }
 
@@ -107,6 +108,19 @@ func InvGammaQtlFor(δ, γ, p float64) float64 {
 	return qtl(p)
 }
 
+// InvGammaNext returns random number drawn from the InvGamma distribution, as the reciprocal of a Gamma(α, 1/β) draw.
+func InvGammaNext(α, β float64) float64 {
+	if isInf(α, 0) || isInf(β, 0) || α <= 0 || β <= 0 {
+		return NaN
+	}
+	return 1 / GammaNext(α, 1/β)
+}
+
+// InvGamma returns the random number generator with  InvGamma distribution.
+func InvGamma(α, β float64) func() float64 {
+	return func() float64 { return InvGammaNext(α, β) }
+}
+
 // InvGammaMean returns the mean of the InvGamma distribution. 
 func InvGammaMean(α, β float64) float64 {
 	if α <= 1 {
@@ -0,0 +1,149 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Laplace (Double Exponential) distribution.
+// The difference of two independent Exponential variables with the same rate; two Exponential densities
+// put back to back at the location μ. Its tails are heavier than those of the Normal distribution, and
+// the median, not the mean, is the maximum likelihood estimate of μ.
+//
+// Parameters:
+// μ ∈ R		location
+// b > 0		scale
+//
+// Support:
+// x ∈ R
+
+func laplaceBad(μ, b float64) bool {
+	return isNaN(μ) || isInf(μ, 0) || !(b > 0) || isInf(b, 1)
+}
+
+// LaplacePDF returns the PDF of the Laplace distribution.
+func LaplacePDF(μ, b float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if laplaceBad(μ, b) || isNaN(x) {
+			return NaN
+		}
+		return exp(-abs(x-μ)/b) / (2 * b)
+	}
+}
+
+// LaplaceLnPDF returns the natural logarithm of the PDF of the Laplace distribution.
+func LaplaceLnPDF(μ, b float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if laplaceBad(μ, b) || isNaN(x) {
+			return NaN
+		}
+		return -abs(x-μ)/b - log(2*b)
+	}
+}
+
+// LaplacePDFAt returns the value of PDF of Laplace distribution at x.
+func LaplacePDFAt(μ, b, x float64) float64 {
+	pdf := LaplacePDF(μ, b)
+	return pdf(x)
+}
+
+// LaplaceCDF returns the CDF of the Laplace distribution.
+func LaplaceCDF(μ, b float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if laplaceBad(μ, b) || isNaN(x) {
+			return NaN
+		}
+		if x < μ {
+			return 0.5 * exp((x-μ)/b)
+		}
+		return 1 - 0.5*exp(-(x-μ)/b)
+	}
+}
+
+// LaplaceCDFAt returns the value of CDF of the Laplace distribution, at x.
+func LaplaceCDFAt(μ, b, x float64) float64 {
+	cdf := LaplaceCDF(μ, b)
+	return cdf(x)
+}
+
+// LaplaceQtl returns the inverse of the CDF (quantile) of the Laplace distribution.
+func LaplaceQtl(μ, b float64) func(p float64) float64 {
+	return func(p float64) float64 {
+		if laplaceBad(μ, b) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		if p < 0.5 {
+			return μ + b*log(2*p)
+		}
+		return μ - b*log(2*(1-p))
+	}
+}
+
+// LaplaceQtlFor returns the inverse of the CDF (quantile) of the Laplace distribution, for given probability.
+func LaplaceQtlFor(μ, b, p float64) float64 {
+	qtl := LaplaceQtl(μ, b)
+	return qtl(p)
+}
+
+// LaplaceNext returns random number drawn from the Laplace distribution.
+func LaplaceNext(μ, b float64) float64 {
+	return defaultSampler.LaplaceNext(μ, b)
+}
+
+// LaplaceNext returns random number drawn from the Laplace distribution, as an Exponential draw
+// with a random sign.
+func (smp *Sampler) LaplaceNext(μ, b float64) float64 {
+	if laplaceBad(μ, b) {
+		return NaN
+	}
+	e := b * smp.rng.ExpFloat64()
+	if smp.rng.Int63()&1 == 0 {
+		return μ - e
+	}
+	return μ + e
+}
+
+// Laplace returns the random number generator with Laplace distribution.
+func Laplace(μ, b float64) func() float64 {
+	return func() float64 { return LaplaceNext(μ, b) }
+}
+
+// LaplaceMean returns the mean of the Laplace distribution.
+func LaplaceMean(μ, b float64) float64 {
+	return μ
+}
+
+// LaplaceMedian returns the median of the Laplace distribution.
+func LaplaceMedian(μ, b float64) float64 {
+	return μ
+}
+
+// LaplaceMode returns the mode of the Laplace distribution.
+func LaplaceMode(μ, b float64) float64 {
+	return μ
+}
+
+// LaplaceVar returns the variance of the Laplace distribution.
+func LaplaceVar(μ, b float64) float64 {
+	return 2 * b * b
+}
+
+// LaplaceStd returns the standard deviation of the Laplace distribution.
+func LaplaceStd(μ, b float64) float64 {
+	return sqrt2 * b
+}
+
+// LaplaceSkew returns the skewness of the Laplace distribution.
+func LaplaceSkew(μ, b float64) float64 {
+	return 0
+}
+
+// LaplaceExKurt returns the excess kurtosis of the Laplace distribution.
+func LaplaceExKurt(μ, b float64) float64 {
+	return 3
+}
+
+// LaplaceEntropy returns the entropy of the Laplace distribution, in nats. It does not depend on μ.
+func LaplaceEntropy(b float64) float64 {
+	if !(b > 0) {
+		return NaN
+	}
+	return 1 + log(2*b)
+}
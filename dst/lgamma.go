@@ -0,0 +1,22 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Logarithm of the Gamma function, on the whole real line.
+// Γ(x) is negative on (-1, 0), (-3, -2), ..., and has poles at 0, -1, -2, ...
+
+import (
+	"math"
+)
+
+// LgammaSign returns the natural logarithm of |Γ(x)|, and the sign of Γ(x).
+// At the poles it returns +Inf.
+func LgammaSign(x float64) (lg float64, sign int) {
+	return math.Lgamma(x)
+}
+
+// Lgamma returns the natural logarithm of |Γ(x)|.
+func Lgamma(x float64) float64 {
+	lg, _ := math.Lgamma(x)
+	return lg
+}
@@ -108,12 +108,9 @@ func LogisticQtl(μ, σ float64) func(p float64) float64 {
 		if isNaN(p) || isNaN(μ) || isNaN(σ) {
 			return p + μ + σ
 		}
-		if σ <= 0 {
+		if σ <= 0 || !(p >= 0 && p <= 1) {
 			return NaN
 		}
-		if σ == 0 {
-			return μ
-		}
 
 		// p := logit(p) = log( p / (1-p) )
 		p = log(p / (1 - p))
@@ -129,8 +126,12 @@ func LogisticQtlFor(μ, σ, p float64) float64 {
 
 // LogisticNext returns random number drawn from the Logistic distribution. 
 func LogisticNext(μ, σ float64) float64 {
-	p := UniformNext(0, 1)
-	return LogisticQtlFor(μ, σ, p)
+	return defaultSampler.LogisticNext(μ, σ)
+}
+
+// LogisticNext returns random number drawn from the Logistic distribution, by inversion.
+func (smp *Sampler) LogisticNext(μ, σ float64) float64 {
+	return LogisticQtlFor(μ, σ, smp.Float64())
 }
 
 // Logistic returns the random number generator with  Logistic distribution. 
@@ -173,7 +174,11 @@ func LogisticExKurt(μ, σ float64) float64 {
 	return 6.0 / 5.0
 }
 
-// LogisticMGF returns the moment-generating function of the Logistic distribution. 
+// LogisticMGF returns the moment-generating function of the Logistic distribution, exp(μt) B(1-σt, 1+σt).
+// It is finite only for |σt| < 1.
 func LogisticMGF(μ, σ, t float64) float64 {
-	return exp(μ*t) * B(0, 2) // TO BE CHECKED
+	if abs(σ*t) >= 1 {
+		return posInf
+	}
+	return exp(μ*t) * B(1-σ*t, 1+σ*t)
 }
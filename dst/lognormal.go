@@ -9,17 +9,41 @@ package dst
 // σ > 0		standard deviation  (scale)
 //
 // Support: 
-// x ∈ R
-
-import (
+// x ∈ (0, ∞)
+//
+// The functions return NaN for μ that is not finite, or σ that is not positive and finite.
+// They are the Normal functions of log(x).
 
-//	"math/rand"
-)
+func logNormalBad(μ, σ float64) bool {
+	return isNaN(μ) || isInf(μ, 0) || !(σ > 0) || isInf(σ, 0)
+}
 
 // LogNormalPDF returns the PDF of the LogNormal distribution. 
 func LogNormalPDF(μ, σ float64) func(x float64) float64 {
-	normalogormalizer := 0.3989422804014327 / σ
-	return func(x float64) float64 { return normalogormalizer * exp(-1*(log(x)-μ)*(log(x)-μ)/(2*σ*σ)) / x }
+	pdf := NormalPDF(μ, σ)
+	return func(x float64) float64 {
+		if logNormalBad(μ, σ) {
+			return NaN
+		}
+		if x <= 0 {
+			return 0
+		}
+		return pdf(log(x)) / x
+	}
+}
+
+// LogNormalLnPDF returns the natural logarithm of the PDF of the LogNormal distribution.
+func LogNormalLnPDF(μ, σ float64) func(x float64) float64 {
+	lnpdf := NormalLnPDF(μ, σ)
+	return func(x float64) float64 {
+		if logNormalBad(μ, σ) {
+			return NaN
+		}
+		if x <= 0 {
+			return negInf
+		}
+		return lnpdf(log(x)) - log(x)
+	}
 }
 
 // LogNormalPDFAt returns the value of PDF of LogNormal distribution at x. 
@@ -30,7 +54,16 @@ func LogNormalPDFAt(μ, σ, x float64) float64 {
 
 // LogNormalCDF returns the CDF of the LogNormal distribution. 
 func LogNormalCDF(μ, σ float64) func(x float64) float64 {
-	return func(x float64) float64 { return ((1.0 / 2.0) * (1 + erf((log(x)-μ)/(σ*sqrt2)))) }
+	cdf := NormalCDF(μ, σ)
+	return func(x float64) float64 {
+		if logNormalBad(μ, σ) {
+			return NaN
+		}
+		if x <= 0 {
+			return 0
+		}
+		return cdf(log(x))
+	}
 }
 
 // LogNormalCDFAt returns the value of CDF of the LogNormal distribution, at x. 
@@ -42,7 +75,10 @@ func LogNormalCDFAt(μ, σ, x float64) float64 {
 // LogNormalQtl returns the inverse of the CDF (quantile) of the LogNormal distribution. 
 func LogNormalQtl(μ, σ float64) func(p float64) float64 {
 	return func(p float64) float64 {
-		return exp(σ*ZQtlFor(p) + μ)
+		if logNormalBad(μ, σ) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		return exp(NormalQtlFor(μ, σ, p))
 	}
 }
 
@@ -53,7 +89,17 @@ func LogNormalQtlFor(μ, σ, p float64) float64 {
 }
 
 // LogNormalNext returns random number drawn from the LogNormal distribution. 
-func LogNormalNext(μ, σ float64) float64 { return exp(NormalNext(μ, σ)) }
+func LogNormalNext(μ, σ float64) float64 {
+	return defaultSampler.LogNormalNext(μ, σ)
+}
+
+// LogNormalNext returns random number drawn from the LogNormal distribution, as exp of a Normal draw.
+func (smp *Sampler) LogNormalNext(μ, σ float64) float64 {
+	if logNormalBad(μ, σ) {
+		return NaN
+	}
+	return exp(smp.NormalNext(μ, σ))
+}
 
 // LogNormal returns the random number generator with  LogNormal distribution. 
 func LogNormal(μ, σ float64) func() float64 {
@@ -77,7 +123,7 @@ func LogNormalMedian(μ, σ float64) float64 {
 
 // LogNormalVar returns the variance of the LogNormal distribution. 
 func LogNormalVar(μ, σ float64) float64 {
-	return exp(σ*σ) - 1*exp(2*μ+σ*σ)
+	return (exp(σ*σ) - 1) * exp(2*μ+σ*σ)
 }
 
 // LogNormalStd returns the standard deviation of the LogNormal distribution. 
@@ -87,7 +133,7 @@ func LogNormalStd(μ, σ float64) float64 {
 
 // LogNormalSkew returns the skewness of the LogNormal distribution. 
 func LogNormalSkew(μ, σ float64) float64 {
-	return exp(σ*σ) + 2*sqrt(exp(σ*σ)-1)
+	return (exp(σ*σ) + 2) * sqrt(exp(σ*σ)-1)
 }
 
 // LogNormalExKurt returns the excess kurtosis of the LogNormal distribution. 
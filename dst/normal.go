@@ -12,10 +12,6 @@ package dst
 // Support: 
 // x ∈ R
 
-import (
-	"math/rand"
-)
-
 func rateval(a []float64, na int64, b []float64, nb int64, x float64) float64 {
 	var (
 		i, j    int64
@@ -124,7 +120,7 @@ func NormalQtlFor(μ, σ, p float64) float64 {
 }
 
 // NormalNext returns random number drawn from the Normal distribution. 
-func NormalNext(μ, σ float64) float64 { return rand.NormFloat64()*σ + μ }
+func NormalNext(μ, σ float64) float64 { return defaultSampler.NormalNext(μ, σ) }
 
 // Normal returns the random number generator with  Normal distribution. 
 func Normal(μ, σ float64) func() float64 {
@@ -3,9 +3,11 @@
 package dst
 
 // Pareto Type I distribution (sometimes referred to as the Bradford distribution). 
+// The power law of incomes, file sizes and insurance claims: P(X > x) = (θ/x)^α above the minimum θ.
+// Its mean is infinite for α <= 1, and its variance for α <= 2.
 //
 // Parameters: 
-// θ > 0.0 (scale) 
+// θ > 0.0 (scale, the minimum value x_m) 
 // α > 0.0 (shape) 
 //
 // Support: 
@@ -15,7 +17,7 @@ package dst
 // ParetoChkParams checks parameters of the Pareto Type I distribution. 
 func ParetoChkParams(θ, α float64) bool {
 	ok := true
-	if α <= 0 || θ <= 0 {
+	if !(α > 0 && θ > 0) {
 		ok = false
 	}
 	return ok
@@ -33,12 +35,26 @@ func ParetoChkSupport(x float64) bool {
 // ParetoPDF returns the PDF of the Pareto Type I distribution. 
 func ParetoPDF(θ, α float64) func(x float64) float64 {
 	return func(x float64) float64 {
+		if !ParetoChkParams(θ, α) || isNaN(x) {
+			return NaN
+		}
 		if x < θ {
 			return 0
 		}
-		t1 := α * pow(θ, α)
-		t2 := pow(x, α+1)
-		return t1 / t2
+		return α / x * pow(θ/x, α)
+	}
+}
+
+// ParetoLnPDF returns the natural logarithm of the PDF of the Pareto Type I distribution.
+func ParetoLnPDF(θ, α float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if !ParetoChkParams(θ, α) || isNaN(x) {
+			return NaN
+		}
+		if x < θ {
+			return negInf
+		}
+		return log(α) + α*log(θ) - (α+1)*log(x)
 	}
 }
 
@@ -51,6 +67,9 @@ func ParetoPDFAt(θ, α, x float64) float64 {
 // ParetoCDF returns the CDF of the Pareto Type I distribution. 
 func ParetoCDF(θ, α float64) func(x float64) float64 {
 	return func(x float64) float64 {
+		if !ParetoChkParams(θ, α) || isNaN(x) {
+			return NaN
+		}
 		if x < θ {
 			return 0
 		}
@@ -67,7 +86,10 @@ func ParetoCDFAt(θ, α, x float64) float64 {
 // ParetoQtl returns the inverse of the CDF (quantile) of the Pareto Type I distribution. 
 func ParetoQtl(θ, α float64) func(p float64) float64 {
 	return func(p float64) float64 {
-		return pow(θ*(1-p), (-1 / α))
+		if !ParetoChkParams(θ, α) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		return θ * pow(1-p, -1/α)
 	}
 }
 
@@ -79,11 +101,18 @@ func ParetoQtlFor(θ, α, p float64) float64 {
 
 // ParetoNext returns random number drawn from the Pareto distribution. 
 func ParetoNext(θ, α float64) (x float64) {
-	p := UniformNext(0, 1)
-	return ParetoQtlFor(θ, α, p)
+	return defaultSampler.ParetoNext(θ, α)
+}
+
+// ParetoNext returns random number drawn from the Pareto distribution, as θ exp(E/α) of an Exponential(1) E.
+func (smp *Sampler) ParetoNext(θ, α float64) float64 {
+	if !ParetoChkParams(θ, α) {
+		return NaN
+	}
+	return θ * exp(smp.rng.ExpFloat64()/α)
 }
 
-// Pareto returns the random number generator with  Planck distribution. 
+// Pareto returns the random number generator with  Pareto distribution. 
 func Pareto(θ, α float64) func() float64 {
 	return func() float64 { return ParetoNext(θ, α) }
 }
@@ -89,7 +89,7 @@ func ParetoIIQtl(θ, α float64) func(p float64) float64 {
 
 // ParetoIIQtlFor returns the inverse of the CDF (quantile) of the Pareto Type II distribution, for given probability.
 func ParetoIIQtlFor(θ, α, p float64) float64 {
-	cdf := ParetoQtl(θ, α)
+	cdf := ParetoIIQtl(θ, α)
 	return cdf(p)
 }
 
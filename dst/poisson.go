@@ -52,13 +52,14 @@ func PoissonPMF(λ float64) func(k int64) float64 {
 }
 
 // PoissonLnPMF returns the natural logarithm of the PMF of the Poisson distribution. 
+// It is k log λ - λ - log k!, computed in the saddle point form of Loader (2000), which neither overflows
+// nor loses precision for counts and rates in the thousands and beyond.
 func PoissonLnPMF(λ float64) func(k int64) float64 {
-	return func(k int64) (p float64) {
-		i := float64(k)
-		a := log(λ) * i
-		b := log(Γ(i + 1))
-		p = a - b - λ
-		return p
+	return func(k int64) float64 {
+		if !(λ >= 0) {
+			return NaN
+		}
+		return dpois_raw_ln(float64(k), λ)
 	}
 }
 
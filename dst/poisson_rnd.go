@@ -6,12 +6,13 @@ package dst
 // rewritten from  Mathlib : A C Library of Special Functions
 // Ahrens and Dieter (1982).
 
-import (
-	"math/rand"
-)
-
 // PoissonNext returns random number drawn from the Poisson distribution. 
 func PoissonNext(λ float64) int64 {
+	return defaultSampler.PoissonNext(λ)
+}
+
+// PoissonNext returns random number drawn from the Poisson distribution.
+func (smp *Sampler) PoissonNext(λ float64) int64 {
 	const (
 		a0     = -0.5
 		a1     = 0.3333333
@@ -97,7 +98,7 @@ func PoissonNext(λ float64) int64 {
 
 		for {
 			// Step U. uniform sample for inversion method
-			u := rand.Float64()
+			u := smp.rng.Float64()
 			if u <= p0 {
 				return 0
 			}
@@ -139,7 +140,7 @@ func PoissonNext(λ float64) int64 {
 	// Only if λ >= 10
 
 	// Step N. normal sample
-	g = λ + s*rand.NormFloat64() // norm_rand() ~ N(0,1), standard normal
+	g = λ + s*smp.rng.NormFloat64() // norm_rand() ~ N(0,1), standard normal
 
 	if g >= 0. {
 		pois = floor(g)
@@ -150,7 +151,7 @@ func PoissonNext(λ float64) int64 {
 		// Step S. squeeze acceptance
 		fk = pois
 		difmuk = λ - fk
-		u = rand.Float64() // ~ U(0,1) - sample
+		u = smp.rng.Float64() // ~ U(0,1) - sample
 		if d*u >= difmuk*difmuk*difmuk {
 			return int64(pois)
 		}
@@ -187,11 +188,11 @@ func PoissonNext(λ float64) int64 {
 		if !stepF {
 			// Step E. Exponential Sample
 
-			E = rand.ExpFloat64() // ~ Exp(1) (standard exponential)
+			E = smp.rng.ExpFloat64() // ~ Exp(1) (standard exponential)
 
 			//  sample t from the laplace 'hat'
 			//    (if t <= -0.6744 then pk < fk for all λ >= 10.)
-			u = 2*rand.Float64() - 1.
+			u = 2*smp.rng.Float64() - 1.
 			t = 1.8 + fsign(E, u)
 		}
 		if t > -0.6744 || stepF {
@@ -4,6 +4,7 @@ package dst
 
 // Pólya distribution. 
 // Extension of the negative binomial distribution to the case of a positive real parameter r. 
+// It is the Gamma-Poisson mixture, and so the predictive distribution of a Poisson count when λ has a gamma posterior.
 //
 // Parameters: 
 // r > 0	 	number of failures until the experiment is stopped (integer, but the definition can also be extended to reals)
@@ -39,9 +40,26 @@ func f_search(p, pr, y, n, incr float64, z *float64) float64 {
 
 // PolyaPMF returns the PMF of the Pólya distribution. 
 func PolyaPMF(ρ, r float64) func(k int64) float64 {
+	lnpmf := PolyaLnPMF(ρ, r)
 	return func(k int64) float64 {
+		return exp(lnpmf(k))
+	}
+}
+
+// PolyaLnPMF returns the natural logarithm of the PMF of the Pólya distribution.
+// It works with log Γ, so that it does not overflow for large k or r.
+func PolyaLnPMF(ρ, r float64) func(k int64) float64 {
+	c := r*log1p(-ρ) - LnΓ(r)
+	lnρ := log(ρ)
+	return func(k int64) float64 {
+		if !(ρ > 0 && ρ < 1 && r > 0) {
+			return NaN
+		}
+		if k < 0 {
+			return negInf
+		}
 		kk := float64(k)
-		return (Γ(kk+r) / (float64(fact(k)) * Γ(r))) * pow(1-ρ, r) * pow(ρ, float64(k))
+		return c + LnΓ(kk+r) - LnΓ(kk+1) + kk*lnρ
 	}
 }
 
@@ -54,6 +72,9 @@ func PolyaPMFAt(ρ, r float64, k int64) float64 {
 // PolyaCDF returns the CDF of the Pólya distribution. 
 func PolyaCDF(ρ, r float64) func(k int64) float64 {
 	return func(k int64) float64 {
+		if k < 0 {
+			return 0
+		}
 		Ip := BetaCDFAt(float64(k+1), r, ρ)
 		return 1 - Ip
 	}
@@ -65,6 +86,22 @@ func PolyaCDFAt(ρ, r float64, k int64) float64 {
 	return cdf(k)
 }
 
+// PolyaNext returns random number drawn from the Pólya distribution.
+func PolyaNext(ρ, r float64) int64 {
+	return defaultSampler.PolyaNext(ρ, r)
+}
+
+// PolyaNext returns random number drawn from the Pólya distribution, as a Poisson draw
+// whose mean is drawn from the Gamma distribution with shape r and scale ρ/(1-ρ).
+func (smp *Sampler) PolyaNext(ρ, r float64) int64 {
+	return smp.PoissonNext(smp.GammaNext(r, ρ/(1-ρ)))
+}
+
+// Polya returns the random number generator with Pólya distribution.
+func Polya(ρ, r float64) func() int64 {
+	return func() int64 { return PolyaNext(ρ, r) }
+}
+
 // PolyaMean returns the mean of the Pólya distribution. 
 func PolyaMean(ρ, r float64) float64 {
 	return ρ * r / (1 - ρ)
@@ -111,7 +148,7 @@ func PolyaPGF(ρ, r float64, z float64) float64 {
 // PolyaQtl returns the inverse of the CDF (quantile) of the Pólya distribution.
 func PolyaQtl(ρ, r float64) func(p float64) int64 {
 	return func(p float64) int64 {
-		var eps, pp, qq, mu, sigma, gamma, z, y float64
+		var pp, qq, mu, sigma, gamma, z, y float64
 		fr := float64(r)
 
 		if ρ <= 0 || ρ > 1 || fr <= 0 { // FIXME: fr = 0 is well defined
@@ -129,7 +166,7 @@ func PolyaQtl(ρ, r float64) func(p float64) int64 {
 		gamma = (qq + pp) / sigma
 
 		// temporary hack --- FIXME ---
-		if p+1.01*eps >= 1. {
+		if p+1.01*eps64 >= 1. {
 			return int64(NaN)
 		}
 
@@ -139,7 +176,7 @@ func PolyaQtl(ρ, r float64) func(p float64) int64 {
 		z = PolyaCDFAt(ρ, r, int64(y))
 
 		// fuzz to ensure left continuity
-		p *= 1 - 64*eps
+		p *= 1 - 64*eps64
 
 		// If the C-F value is not too large a simple search is OK
 		if y < 1e5 {
@@ -0,0 +1,46 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Quantile of an arbitrary monotone CDF by bracket expansion and FindRoot.
+// The bracket starts at [-1, 1] and its ends are pushed outward, doubling the step,
+// until it straddles p, so the support of the distribution need not be known.
+
+// QuantileAuto returns x with cdf(x) = p (for a step CDF, the step where cdf crosses p), or NaN if p is not in (0, 1),
+// cdf returns NaN, or no bracket is found within QtlMaxIter doublings.
+func QuantileAuto(cdf func(float64) float64, p float64) float64 {
+	if !(p > 0 && p < 1) {
+		return NaN
+	}
+
+	// expand the bracket: cdf(lo) < p <= cdf(hi)
+	lo, hi := -1.0, 1.0
+	flo, fhi := cdf(lo), cdf(hi)
+	for step, i := 1.0, 0; flo >= p; i++ {
+		if i == QtlMaxIter || isInf(lo, 0) {
+			return NaN
+		}
+		hi, fhi = lo, flo
+		step *= 2
+		lo -= step
+		flo = cdf(lo)
+	}
+	for step, i := 1.0, 0; fhi < p; i++ {
+		if i == QtlMaxIter || isInf(hi, 0) {
+			return NaN
+		}
+		lo, flo = hi, fhi
+		step *= 2
+		hi += step
+		fhi = cdf(hi)
+	}
+	if isNaN(flo) || isNaN(fhi) {
+		return NaN
+	}
+
+	x, err := FindRoot(func(x float64) float64 { return cdf(x) - p }, lo, hi, QtlTol*max(1, max(abs(lo), abs(hi))))
+	if err != nil {
+		return NaN
+	}
+	return x
+}
@@ -0,0 +1,102 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Root of a function of one variable on a sign-changing bracket, by Brent's method.
+// Inverse quadratic interpolation and the secant method are used while they make good progress,
+// with bisection as the fallback, so the bracket always shrinks and convergence is guaranteed.
+// Ref.: Brent 1973: Algorithms for minimization without derivatives, Chapter 4; netlib zeroin.f.
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNoBracket is returned by FindRoot if f(lo) and f(hi) do not differ in sign.
+	ErrNoBracket = errors.New("dst: f(lo) and f(hi) must have opposite signs")
+	// ErrRootNaN is returned by FindRoot if f returns NaN.
+	ErrRootNaN = errors.New("dst: f returned NaN")
+	// ErrNoConvergence is returned by FindRoot if QtlMaxIter iterations are not enough.
+	ErrNoConvergence = errors.New("dst: root not found within QtlMaxIter iterations")
+)
+
+// FindRoot returns x in [lo, hi] with f(x) = 0, to within tol + 4ε|x|, where ε is the machine precision.
+// f(lo) and f(hi) must have opposite signs (or one of them be zero); f may be infinite at the ends of the bracket.
+func FindRoot(f func(float64) float64, lo, hi, tol float64) (float64, error) {
+	if isNaN(lo) || isNaN(hi) || !(tol >= 0) {
+		return NaN, errors.New("dst: bracket ends must be numbers, and tol non-negative")
+	}
+	a, b := lo, hi
+	fa, fb := f(a), f(b)
+	switch {
+	case isNaN(fa) || isNaN(fb):
+		return NaN, ErrRootNaN
+	case fa == 0:
+		return a, nil
+	case fb == 0:
+		return b, nil
+	case (fa > 0) == (fb > 0):
+		return NaN, ErrNoBracket
+	}
+
+	// b is the best estimate, a the previous one, and the root lies between b and c
+	c, fc := b, fb
+	var d, e float64
+	for i := 0; i < QtlMaxIter; i++ {
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if abs(fc) < abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol1 := 4*eps64*abs(b) + 0.5*tol
+		xm := 0.5 * (c - b)
+		if abs(xm) <= tol1 || fb == 0 {
+			return b, nil
+		}
+
+		if abs(e) >= tol1 && abs(fa) > abs(fb) {
+			var p, q float64
+			s := fb / fa
+			if a == c { // secant
+				p = 2 * xm * s
+				q = 1 - s
+			} else { // inverse quadratic interpolation
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*xm*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = abs(p)
+			// accept the interpolation only if it stays inside the bracket and converges fast enough
+			if 2*p < min(3*xm*q-abs(tol1*q), abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = xm
+				e = d
+			}
+		} else {
+			d = xm
+			e = d
+		}
+
+		a, fa = b, fb
+		if abs(d) > tol1 {
+			b += d
+		} else {
+			b += fsign(tol1, xm)
+		}
+		fb = f(b)
+		if isNaN(fb) {
+			return NaN, ErrRootNaN
+		}
+	}
+	return b, ErrNoConvergence
+}
@@ -0,0 +1,120 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Random number generation from an explicit source.
+// A Sampler draws from its own *rand.Rand, so a simulation seeded with NewSampler(seed) is reproducible,
+// and parallel Monte Carlo can give each goroutine its own Sampler. A Sampler is not safe for concurrent use.
+// The free XxxNext functions draw from the default Sampler, which uses the global math/rand source
+// and is safe for concurrent use.
+
+import (
+	"math/rand"
+)
+
+// Sampler draws random numbers from the distributions of this package, using its own source.
+type Sampler struct {
+	rng *rand.Rand
+}
+
+// NewSampler returns a Sampler seeded with seed.
+func NewSampler(seed int64) *Sampler {
+	return &Sampler{rand.New(rand.NewSource(seed))}
+}
+
+// NewSamplerRand returns a Sampler drawing from rng.
+func NewSamplerRand(rng *rand.Rand) *Sampler {
+	return &Sampler{rng}
+}
+
+// globalSource is the global math/rand source, as a rand.Source.
+type globalSource struct{}
+
+func (globalSource) Int63() int64    { return rand.Int63() }
+func (globalSource) Uint64() uint64  { return rand.Uint64() }
+func (globalSource) Seed(seed int64) {}
+
+// defaultSampler is used by the free XxxNext functions.
+var defaultSampler = &Sampler{rand.New(globalSource{})}
+
+// Rand returns the source of the Sampler, for functions that take a *rand.Rand.
+func (smp *Sampler) Rand() *rand.Rand {
+	return smp.rng
+}
+
+// Float64 returns random number drawn from the Uniform distribution on [0, 1).
+func (smp *Sampler) Float64() float64 {
+	return smp.rng.Float64()
+}
+
+// UniformNext returns random number drawn from the Uniform distribution on [a, b).
+func (smp *Sampler) UniformNext(a, b float64) float64 {
+	return a + (b-a)*smp.rng.Float64()
+}
+
+// NormalNext returns random number drawn from the Normal distribution.
+func (smp *Sampler) NormalNext(μ, σ float64) float64 {
+	return smp.rng.NormFloat64()*σ + μ
+}
+
+// ExponentialNext returns random number drawn from the Exponential distribution with rate λ.
+func (smp *Sampler) ExponentialNext(λ float64) float64 {
+	if expBad(λ) {
+		return NaN
+	}
+	return smp.rng.ExpFloat64() / λ
+}
+
+// GammaNext returns random number drawn from the Gamma distribution with shape α and scale θ.
+func (smp *Sampler) GammaNext(α float64, θ float64) float64 {
+	//if α is a small integer, this way is faster on my laptop
+	if α == float64(int64(α)) && α <= 15 {
+		// ExponentialNext takes the rate, 1/θ
+		x := smp.ExponentialNext(1 / θ)
+		for i := 1; i < int(α); i++ {
+			x += smp.ExponentialNext(1 / θ)
+		}
+		return x
+	}
+
+	if α < 1 { // X = Y * U^(1/α), Y ~ Gamma(α+1, θ); Marsaglia and Tsang 2000
+		return smp.GammaNext(α+1, θ) * pow(smp.Float64(), 1/α)
+	}
+
+	//Tadikamalla ACM '73
+	a := α - 1
+	b := 0.5 + 0.5*sqrt(4*α-3)
+	c := a * (1 + b) / b
+	d := (b - 1) / (a * b)
+	s := a / b
+	p := 1.0 / (2 - exp(-s))
+	var x, y float64
+	for i := 1; ; i++ {
+		u := smp.Float64()
+		if u > p {
+			var e float64
+			for e = -log((1 - u) / (1 - p)); e > s; e = e - a/b {
+			}
+			x = a - b*e
+			y = a - x
+		} else {
+			x = a - b*log(u/p)
+			y = x - a
+		}
+		u2 := smp.Float64()
+		if log(u2) <= a*log(d*x)-x+y/b+c {
+			break
+		}
+	}
+	return x * θ
+}
+
+// BetaNext returns random number drawn from the Beta distribution, as X/(X+Y) of independent Gamma variables.
+func (smp *Sampler) BetaNext(α, β float64) float64 {
+	if α == 1 && β == 1 { // uniform case
+		return smp.Float64()
+	}
+	x := smp.GammaNext(α, 1)
+	y := smp.GammaNext(β, 1)
+	return x / (x + y)
+}
@@ -13,12 +13,22 @@ package dst
 
 // StudentsTPDF returns the PDF of the Student's t distribution. 
 func StudentsTPDF(ν float64) func(x float64) float64 {
-	normalization := Γ((ν+1)/2) / (sqrt(ν*π) * Γ(ν/2))
+	// log-gamma keeps the normalization finite for large ν
+	normalization := exp(LnΓ((ν+1)/2)-LnΓ(ν/2)) / sqrt(ν*π)
 	return func(x float64) float64 {
+		if ν <= 0 {
+			return NaN
+		}
 		return normalization * pow(1+x*x/ν, -(ν+1)/2)
 	}
 }
 
+// StudentsTPDFAt returns the value of PDF of the Student's t distribution, at x. 
+func StudentsTPDFAt(ν, x float64) float64 {
+	pdf := StudentsTPDF(ν)
+	return pdf(x)
+}
+
 // StudentsTLnPDF returns the natural logarithm of the PDF of the Student's t distribution. 
 func StudentsTLnPDF(ν float64) func(x float64) float64 {
 	normalization := LnΓ((ν+1)/2) - log(sqrt(ν*π)) - LnΓ(ν/2)
@@ -44,17 +54,8 @@ func StudentsTCDF(ν float64) func(x float64) float64 {
 			p = -0.5*ν*(2*log(abs(x))-log(ν)) - logB(0.5*ν, 0.5) - log(0.5*ν)
 			p = exp(p)
 		} else {
-			if ν > x*x {
-				α := 0.5
-				β := ν / 2
-				pbeta := BetaCDF(α, β)
-				p = 1 - pbeta(x*x/(ν+x*x))
-			} else {
-				α := ν / 2
-				β := 0.5
-				pbeta := BetaCDF(α, β)
-				p = pbeta(1 / nx)
-			}
+			// P(|T| > |x|) = I(ν/(ν+x²); ν/2, 1/2), both arguments formed without cancellation
+			p, _ = betaIncPair(0.5*ν, 0.5, 1/nx, x*x/(ν+x*x))
 		}
 
 		p /= 2
@@ -88,38 +89,32 @@ func StudentsTQtl(ν float64) func(p float64) float64 {
 			return NaN
 		}
 
-		/*
-			    if (ν < 1) { // based on qnt
-				const static double accu = 1e-13;
-				const static double Eps = 1e-11; // must be > accu
-
-				double ux, lx, nx, pp;
-
-				int iter = 0;
-
-				p = RDTqIv(p);
-
-				// Invert pt(.) :
-				// 1. finding an upper and lower bound
-				if(p > 1 - min64) return MLPOSINF;
-				pp = fmin2(1 - min64, p// (1 + Eps));
-				for(ux = 1.; ux < DBLMAX && pt(ux, ν, TRUE, FALSE) < pp; ux//= 2);
-				pp = p// (1 - Eps);
-				for(lx =-1.; lx > -DBLMAX && pt(lx, ν, TRUE, FALSE) > pp; lx//= 2);
-
-				// 2. interval (lx,ux)  halving
-				   regula falsi failed on qt(0.1, 0.1)
-
-				do {
-				    nx = 0.5// (lx + ux);
-				    if (pt(nx, ν, TRUE, FALSE) > p) ux = nx; else lx = nx;
-				} while ((ux - lx) / abs(nx) > accu && ++iter < 1000);
+		if ν < 1 { // based on qnt: root of the CDF on a bracket
+			if p == 0 {
+				return negInf
+			}
+			if p == 1 {
+				return posInf
+			}
+			pt := StudentsTCDF(ν)
 
-				if(iter >= 1000) MLERROR(MEPRECISION, "qt");
+			// 1. finding an upper and lower bound
+			ux := 1.0
+			for ux < max64 && pt(ux) < p {
+				ux *= 2
+			}
+			lx := -1.0
+			for lx > -max64 && pt(lx) > p {
+				lx *= 2
+			}
 
-				return 0.5// (lx + ux);
-			    }
-		*/
+			// 2. root in (lx,ux), to machine precision relative to x
+			x, err := FindRoot(func(x float64) float64 { return pt(x) - p }, lx, ux, 0)
+			if err != nil && err != ErrNoConvergence {
+				return NaN
+			}
+			return x
+		}
 
 		if ν > 1e20 {
 			q = ZQtlFor(p)
@@ -164,6 +159,9 @@ func StudentsTQtl(ν float64) func(p float64) float64 {
 				y = pow(d*p, 2/ν)
 				if y >= min64 {
 					pok = true
+				} else { // d*p underflows after the power: work with logs
+					x = (log(d) + log(p)) / ν
+					y = exp(2 * x)
 				}
 				if (ν < 2.1 && p > 0.5) || y > 0.05+a { // p > p0(df)
 					// Asymptotic inverse expansion about normal
@@ -179,7 +177,7 @@ func StudentsTQtl(ν float64) func(p float64) float64 {
 					q = sqrt(ν * y)
 				} else { // re-use 'y' from above
 
-					if !pok && x < -0.5*log(min64) { // 0.5* log(min64)
+					if !pok && x < -Ln2*53 { // 53 = mantissa bits of float64
 						// y above might have underflown
 						q = sqrt(ν) * exp(-x)
 					} else {
@@ -196,7 +194,7 @@ func StudentsTQtl(ν float64) func(p float64) float64 {
 					if y <= 0 {
 						break
 					}
-					x = (1 - pt(q) - p/2) / y
+					x = (pt(-q) - p/2) / y // upper tail by symmetry, avoids cancellation in 1 - pt(q)
 					if abs(x) > 1e-14*abs(q) {
 						// Newton (=Taylor 1 term):
 						//  q += x 
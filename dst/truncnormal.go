@@ -0,0 +1,211 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Truncated Normal distribution.
+// The Normal(μ, σ) distribution restricted to the interval [lo, hi] and renormalized, e.g. for a parameter that must
+// be positive (lo = 0, hi = +Inf) or lie in [0, 1]. Either limit may be infinite; with both infinite it is Normal(μ, σ).
+// The normalizing mass Φ(b) - Φ(a), with a = (lo-μ)/σ and b = (hi-μ)/σ, is taken from the upper tail, Φ(-a) - Φ(-b),
+// when the interval lies above μ, so that it keeps its precision far out in either tail.
+//
+// Parameters:
+// μ ∈ R		location of the untruncated Normal
+// σ > 0		scale of the untruncated Normal
+// lo < hi		limits, lo ∈ [-∞, ∞), hi ∈ (-∞, ∞]
+//
+// Support:
+// x ∈ [lo, hi]
+
+func truncNormalBad(μ, σ, lo, hi float64) bool {
+	return isNaN(μ) || isInf(μ, 0) || !(σ > 0) || isInf(σ, 1) || isNaN(lo) || isNaN(hi) || !(lo < hi)
+}
+
+// stdNormalCDF returns Φ(x) as 0.5 erfc(-x/√2), which, unlike 0.5 (1 + erf(x/√2)), keeps its relative precision for x << 0.
+func stdNormalCDF(x float64) float64 {
+	return 0.5 * erfc(-x/sqrt2)
+}
+
+// xPhi returns x φ(x), 0 for infinite x.
+func xPhi(x float64) float64 {
+	if isInf(x, 0) {
+		return 0
+	}
+	return x * ZPDFAt(x)
+}
+
+// truncNormalLimits returns the standardized limits a, b, the Normal mass between them, and whether it is taken from the upper tail.
+func truncNormalLimits(μ, σ, lo, hi float64) (a, b, mass float64, upper bool) {
+	a, b = (lo-μ)/σ, (hi-μ)/σ
+	if a > 0 {
+		return a, b, stdNormalCDF(-a) - stdNormalCDF(-b), true
+	}
+	return a, b, stdNormalCDF(b) - stdNormalCDF(a), false
+}
+
+// TruncNormalPDF returns the PDF of the Truncated Normal distribution.
+func TruncNormalPDF(μ, σ, lo, hi float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if truncNormalBad(μ, σ, lo, hi) || isNaN(x) {
+			return NaN
+		}
+		if x < lo || x > hi {
+			return 0
+		}
+		_, _, mass, _ := truncNormalLimits(μ, σ, lo, hi)
+		return ZPDFAt((x-μ)/σ) / (σ * mass)
+	}
+}
+
+// TruncNormalLnPDF returns the natural logarithm of the PDF of the Truncated Normal distribution.
+func TruncNormalLnPDF(μ, σ, lo, hi float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if truncNormalBad(μ, σ, lo, hi) || isNaN(x) {
+			return NaN
+		}
+		if x < lo || x > hi {
+			return negInf
+		}
+		_, _, mass, _ := truncNormalLimits(μ, σ, lo, hi)
+		z := (x - μ) / σ
+		return -M_LN_SQRT_2PI - z*z/2 - log(σ) - log(mass)
+	}
+}
+
+// TruncNormalPDFAt returns the value of PDF of Truncated Normal distribution at x.
+func TruncNormalPDFAt(μ, σ, lo, hi, x float64) float64 {
+	pdf := TruncNormalPDF(μ, σ, lo, hi)
+	return pdf(x)
+}
+
+// TruncNormalCDF returns the CDF of the Truncated Normal distribution.
+func TruncNormalCDF(μ, σ, lo, hi float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if truncNormalBad(μ, σ, lo, hi) || isNaN(x) {
+			return NaN
+		}
+		if x <= lo {
+			return 0
+		}
+		if x >= hi {
+			return 1
+		}
+		a, _, mass, upper := truncNormalLimits(μ, σ, lo, hi)
+		z := (x - μ) / σ
+		var p float64
+		if upper {
+			p = (stdNormalCDF(-a) - stdNormalCDF(-z)) / mass
+		} else {
+			p = (stdNormalCDF(z) - stdNormalCDF(a)) / mass
+		}
+		if p > 1 {
+			p = 1
+		}
+		return p
+	}
+}
+
+// TruncNormalCDFAt returns the value of CDF of the Truncated Normal distribution, at x.
+func TruncNormalCDFAt(μ, σ, lo, hi, x float64) float64 {
+	cdf := TruncNormalCDF(μ, σ, lo, hi)
+	return cdf(x)
+}
+
+// TruncNormalQtl returns the inverse of the CDF (quantile) of the Truncated Normal distribution.
+// It inverts Φ directly: Φ((x-μ)/σ) = Φ(a) + p (Φ(b) - Φ(a)).
+func TruncNormalQtl(μ, σ, lo, hi float64) func(p float64) float64 {
+	return func(p float64) float64 {
+		if truncNormalBad(μ, σ, lo, hi) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		if p == 0 {
+			return lo
+		}
+		if p == 1 {
+			return hi
+		}
+		a, _, mass, upper := truncNormalLimits(μ, σ, lo, hi)
+		var z float64
+		if upper {
+			z = -ZQtlFor(stdNormalCDF(-a) - p*mass)
+		} else {
+			z = ZQtlFor(stdNormalCDF(a) + p*mass)
+		}
+		x := μ + σ*z
+		// rounding may put x just outside the limits
+		if x < lo {
+			x = lo
+		}
+		if x > hi {
+			x = hi
+		}
+		return x
+	}
+}
+
+// TruncNormalQtlFor returns the inverse of the CDF (quantile) of the Truncated Normal distribution, for given probability.
+func TruncNormalQtlFor(μ, σ, lo, hi, p float64) float64 {
+	qtl := TruncNormalQtl(μ, σ, lo, hi)
+	return qtl(p)
+}
+
+// TruncNormalNext returns random number drawn from the Truncated Normal distribution.
+func TruncNormalNext(μ, σ, lo, hi float64) float64 {
+	return defaultSampler.TruncNormalNext(μ, σ, lo, hi)
+}
+
+// TruncNormalNext returns random number drawn from the Truncated Normal distribution, by inversion of the CDF.
+// Unlike rejection of Normal draws outside [lo, hi], it takes one uniform draw however little mass the interval has.
+func (smp *Sampler) TruncNormalNext(μ, σ, lo, hi float64) float64 {
+	if truncNormalBad(μ, σ, lo, hi) {
+		return NaN
+	}
+	return TruncNormalQtlFor(μ, σ, lo, hi, smp.Float64())
+}
+
+// TruncNormal returns the random number generator with Truncated Normal distribution.
+func TruncNormal(μ, σ, lo, hi float64) func() float64 {
+	return func() float64 { return TruncNormalNext(μ, σ, lo, hi) }
+}
+
+// TruncNormalMean returns the mean μ + σ (φ(a) - φ(b)) / (Φ(b) - Φ(a)) of the Truncated Normal distribution.
+func TruncNormalMean(μ, σ, lo, hi float64) float64 {
+	if truncNormalBad(μ, σ, lo, hi) {
+		return NaN
+	}
+	a, b, mass, _ := truncNormalLimits(μ, σ, lo, hi)
+	return μ + σ*(ZPDFAt(a)-ZPDFAt(b))/mass
+}
+
+// TruncNormalMedian returns the median of the Truncated Normal distribution.
+func TruncNormalMedian(μ, σ, lo, hi float64) float64 {
+	return TruncNormalQtlFor(μ, σ, lo, hi, 0.5)
+}
+
+// TruncNormalMode returns the mode of the Truncated Normal distribution, μ moved into [lo, hi].
+func TruncNormalMode(μ, σ, lo, hi float64) float64 {
+	if truncNormalBad(μ, σ, lo, hi) {
+		return NaN
+	}
+	if μ < lo {
+		return lo
+	}
+	if μ > hi {
+		return hi
+	}
+	return μ
+}
+
+// TruncNormalVar returns the variance of the Truncated Normal distribution.
+func TruncNormalVar(μ, σ, lo, hi float64) float64 {
+	if truncNormalBad(μ, σ, lo, hi) {
+		return NaN
+	}
+	a, b, mass, _ := truncNormalLimits(μ, σ, lo, hi)
+	d := (ZPDFAt(a) - ZPDFAt(b)) / mass
+	return σ * σ * (1 + (xPhi(a)-xPhi(b))/mass - d*d)
+}
+
+// TruncNormalStd returns the standard deviation of the Truncated Normal distribution.
+func TruncNormalStd(μ, σ, lo, hi float64) float64 {
+	return sqrt(TruncNormalVar(μ, σ, lo, hi))
+}
@@ -12,10 +12,6 @@ package dst
 // Support: 
 // x ∈ [a, b]		(real)
 
-import (
-	"math/rand"
-)
-
 // UniformPDF returns the PDF of the Uniform distribution. 
 func UniformPDF(a, b float64) func(x float64) float64 {
 	return func(x float64) float64 {
@@ -63,7 +59,7 @@ func UniformCDFAt(a, b, x float64) float64 {
 
 // UniformNext returns random number drawn from the Uniform distribution. 
 func UniformNext(a, b float64) float64 {
-	return a + (b-a)*rand.Float64()
+	return defaultSampler.UniformNext(a, b)
 }
 
 // Uniform returns the random number generator with  Uniform distribution. 
@@ -0,0 +1,162 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package dst
+
+// Weibull distribution.
+// The distribution of lifetimes in reliability and survival analysis. Its hazard rate k/λ (x/λ)^(k-1) falls with age
+// for k < 1 (early failures), is constant for k = 1 (the Exponential distribution with rate 1/λ), and rises for k > 1
+// (wear-out); k = 2 is the Rayleigh distribution.
+//
+// Parameters:
+// k > 0		shape
+// λ > 0		scale
+//
+// Support:
+// x ∈ [0, ∞)
+
+func weibullBad(k, λ float64) bool {
+	return !(k > 0 && λ > 0) || isInf(k, 1) || isInf(λ, 1)
+}
+
+// WeibullPDF returns the PDF of the Weibull distribution.
+func WeibullPDF(k, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if weibullBad(k, λ) || isNaN(x) {
+			return NaN
+		}
+		if x < 0 {
+			return 0
+		}
+		z := x / λ
+		return k / λ * pow(z, k-1) * exp(-pow(z, k))
+	}
+}
+
+// WeibullLnPDF returns the natural logarithm of the PDF of the Weibull distribution.
+func WeibullLnPDF(k, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if weibullBad(k, λ) || isNaN(x) {
+			return NaN
+		}
+		if x < 0 {
+			return negInf
+		}
+		z := x / λ
+		return log(k/λ) + (k-1)*log(z) - pow(z, k)
+	}
+}
+
+// WeibullPDFAt returns the value of PDF of Weibull distribution at x.
+func WeibullPDFAt(k, λ, x float64) float64 {
+	pdf := WeibullPDF(k, λ)
+	return pdf(x)
+}
+
+// WeibullCDF returns the CDF of the Weibull distribution.
+func WeibullCDF(k, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if weibullBad(k, λ) || isNaN(x) {
+			return NaN
+		}
+		if x <= 0 {
+			return 0
+		}
+		return -expm1(-pow(x/λ, k))
+	}
+}
+
+// WeibullCDFAt returns the value of CDF of the Weibull distribution, at x.
+func WeibullCDFAt(k, λ, x float64) float64 {
+	cdf := WeibullCDF(k, λ)
+	return cdf(x)
+}
+
+// WeibullQtl returns the inverse of the CDF (quantile) of the Weibull distribution.
+func WeibullQtl(k, λ float64) func(p float64) float64 {
+	return func(p float64) float64 {
+		if weibullBad(k, λ) || !(p >= 0 && p <= 1) {
+			return NaN
+		}
+		return λ * pow(-log1p(-p), 1/k)
+	}
+}
+
+// WeibullQtlFor returns the inverse of the CDF (quantile) of the Weibull distribution, for given probability.
+func WeibullQtlFor(k, λ, p float64) float64 {
+	qtl := WeibullQtl(k, λ)
+	return qtl(p)
+}
+
+// WeibullHazard returns the hazard rate PDF(x)/(1-CDF(x)) = k/λ (x/λ)^(k-1) of the Weibull distribution,
+// the failure rate at age x of the items that have survived to x.
+func WeibullHazard(k, λ float64) func(x float64) float64 {
+	return func(x float64) float64 {
+		if weibullBad(k, λ) || isNaN(x) {
+			return NaN
+		}
+		if x < 0 {
+			return 0
+		}
+		return k / λ * pow(x/λ, k-1)
+	}
+}
+
+// WeibullHazardAt returns the hazard rate of the Weibull distribution, at x.
+func WeibullHazardAt(k, λ, x float64) float64 {
+	h := WeibullHazard(k, λ)
+	return h(x)
+}
+
+// WeibullNext returns random number drawn from the Weibull distribution.
+func WeibullNext(k, λ float64) float64 {
+	return defaultSampler.WeibullNext(k, λ)
+}
+
+// WeibullNext returns random number drawn from the Weibull distribution, as λ E^(1/k) of an Exponential(1) E.
+func (smp *Sampler) WeibullNext(k, λ float64) float64 {
+	if weibullBad(k, λ) {
+		return NaN
+	}
+	return λ * pow(smp.rng.ExpFloat64(), 1/k)
+}
+
+// Weibull returns the random number generator with Weibull distribution.
+func Weibull(k, λ float64) func() float64 {
+	return func() float64 { return WeibullNext(k, λ) }
+}
+
+// WeibullMean returns the mean of the Weibull distribution.
+func WeibullMean(k, λ float64) float64 {
+	return λ * Γ(1+1/k)
+}
+
+// WeibullMedian returns the median of the Weibull distribution.
+func WeibullMedian(k, λ float64) float64 {
+	return λ * pow(Ln2, 1/k)
+}
+
+// WeibullMode returns the mode of the Weibull distribution.
+func WeibullMode(k, λ float64) float64 {
+	if k <= 1 {
+		return 0
+	}
+	return λ * pow((k-1)/k, 1/k)
+}
+
+// WeibullVar returns the variance of the Weibull distribution.
+func WeibullVar(k, λ float64) float64 {
+	g1 := Γ(1 + 1/k)
+	return λ * λ * (Γ(1+2/k) - g1*g1)
+}
+
+// WeibullStd returns the standard deviation of the Weibull distribution.
+func WeibullStd(k, λ float64) float64 {
+	return sqrt(WeibullVar(k, λ))
+}
+
+// WeibullSkew returns the skewness of the Weibull distribution.
+func WeibullSkew(k, λ float64) float64 {
+	g1, g2, g3 := Γ(1+1/k), Γ(1+2/k), Γ(1+3/k)
+	v := g2 - g1*g1
+	return (g3 - 3*g1*v - g1*g1*g1) / (v * sqrt(v))
+}
@@ -0,0 +1,31 @@
+package stat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCorrelationCI(t *testing.T) {
+	fmt.Println("Testing CorrelationCI")
+	// r = 0.5, n = 30: 95% CI (0.1704, 0.7290)
+	lo, hi := CorrelationCI(0.5, 30, 0.05)
+	if abs(lo-0.1704) > 1e-4 || abs(hi-0.7290) > 1e-4 {
+		fmt.Println("failed: ", lo, hi)
+		t.Error()
+	}
+	// symmetric in z-space
+	z := math.Atanh(0.5)
+	if !check(z-math.Atanh(lo), math.Atanh(hi)-z) {
+		fmt.Println("failed: not symmetric in z ", lo, hi)
+		t.Error()
+	}
+	// within [-1, 1]
+	for _, r := range []float64{-0.99, -0.5, 0, 0.95} {
+		lo, hi := CorrelationCI(r, 5, 0.01)
+		if lo < -1 || hi > 1 || lo > r || hi < r {
+			fmt.Println("failed: ", r, lo, hi)
+			t.Error()
+		}
+	}
+}
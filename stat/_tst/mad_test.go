@@ -0,0 +1,59 @@
+package stat
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"testing"
+)
+
+// For large Normal samples MAD is close to σ.
+func TestMADNormal(t *testing.T) {
+	fmt.Println("Testing MAD, Normal data")
+	m := 1000000
+	sd := 3.0
+	d := make([]float64, m)
+	for i := range d {
+		d[i] = dst.NormalNext(0, sd)
+	}
+	x := MAD(d)
+	if abs(x-sd) > 2e-2 {
+		fmt.Println("failed: x, y ", x, sd)
+		t.Error()
+	}
+}
+
+// MAD is far more stable than the sample standard deviation when outliers are present.
+func TestMADOutlier(t *testing.T) {
+	fmt.Println("Testing MAD, outliers")
+	m := 10000
+	sd := 1.0
+	d := make([]float64, m)
+	for i := range d {
+		d[i] = dst.NormalNext(0, sd)
+	}
+	for i := 0; i < 100; i++ {
+		d[i] = 1000
+	}
+	x := MAD(d)
+	_, s2 := SampleMeanVar(d)
+	if abs(x-sd) > 0.1 || sqrt(s2) < 10*sd {
+		fmt.Println("failed: MAD, SD ", x, sqrt(s2))
+		t.Error()
+	}
+}
+
+// Test against R: mad(c(1, 2, 3, 4, 100)) = 1.4826
+func TestMAD(t *testing.T) {
+	fmt.Println("Testing MAD")
+	x := MAD([]float64{1, 2, 3, 4, 100})
+	y := 1.4826
+	if abs(x-y) > 1e-4 {
+		fmt.Println("failed: x, y ", x, y)
+		t.Error()
+	}
+	x = Median([]float64{4, 1, 3, 2})
+	if x != 2.5 {
+		fmt.Println("failed: median ", x)
+		t.Error()
+	}
+}
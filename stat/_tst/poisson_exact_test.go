@@ -0,0 +1,70 @@
+package stat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// Test against R: poisson.test(x, T = 1, r = m, alternative = ...)$p.value
+func TestPoissonExactTest(t *testing.T) {
+	fmt.Println("Testing PoissonExactTest")
+	x := []int64{10, 2, 137, 0}
+	m := []float64{5, 8, 100, 3}
+	two := []float64{0.03856600430529077, 0.0310109581419709, 0.0004498086572798631, 0.08329560367670508}
+	less := []float64{0.9863047314016166, 0.01375396774400299, 0.9998158905331638, 0.049787068367863944}
+	greater := []float64{0.031828057306205304, 0.9969808363488774, 0.0002583196909770047, 1}
+	for i := range x {
+		p := PoissonExactTest(x[i], m[i], "two.sided")
+		if !check(p, two[i]) {
+			fmt.Println("failed: two-sided ", x[i], m[i], p, two[i])
+			t.Error()
+		}
+		p = PoissonExactTest(x[i], m[i], "less")
+		if !check(p, less[i]) {
+			fmt.Println("failed: less ", x[i], m[i], p, less[i])
+			t.Error()
+		}
+		p = PoissonExactTest(x[i], m[i], "greater")
+		if !check(p, greater[i]) {
+			fmt.Println("failed: greater ", x[i], m[i], p, greater[i])
+			t.Error()
+		}
+	}
+	if p := PoissonExactTest(5, 5, "two.sided"); p != 1 {
+		fmt.Println("failed: observed = expected ", p)
+		t.Error()
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				fmt.Println("failed: no panic for a bad alternative")
+				t.Error()
+			}
+		}()
+		PoissonExactTest(5, 3, "two-sided")
+	}()
+}
+
+func TestPoissonExactTestLargeExpected(t *testing.T) {
+	fmt.Println("Testing PoissonExactTest with a large expected count")
+	// 3 standard deviations either side; the Normal approximation is 2(1 - Φ(3)) = 0.0026997960632601866
+	const m = 1e10
+	for _, x := range []int64{m + 3e5, m - 3e5} {
+		if p := PoissonExactTest(x, m, "two.sided"); abs(p-0.0026997960632601866) > 1e-5 {
+			fmt.Println("failed: ", x, p)
+			t.Error()
+		}
+	}
+	for _, m := range []float64{nan, math.Inf(1)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					fmt.Println("failed: no panic for expected ", m)
+					t.Error()
+				}
+			}()
+			PoissonExactTest(5, m, "two.sided")
+		}()
+	}
+}
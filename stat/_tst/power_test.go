@@ -0,0 +1,41 @@
+package stat
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Test against Cohen (1988) power tables and R:pwr::pwr.t.test()
+func TestTTestPower(t *testing.T) {
+	fmt.Println("Testing TTestPower")
+	n := []int{64, 26, 20, 100}
+	d := []float64{0.5, 0.8, 1, 0.2}
+	y := []float64{0.8014596, 0.8074866, 0.8689528, 0.2906459}
+	for i := range n {
+		x := TTestPower(n[i], d[i], 0.05)
+		if abs(x-y[i]) > 1e-4 {
+			fmt.Println("failed: ", n[i], d[i], x, y[i])
+			t.Error()
+		}
+	}
+
+	fmt.Println("Testing TTestPower, monotonicity")
+	last := 0.0
+	for n := 5; n <= 100; n += 5 {
+		x := TTestPower(n, 0.5, 0.05)
+		if x <= last {
+			fmt.Println("failed: power does not increase with n ", n, x, last)
+			t.Error()
+		}
+		last = x
+	}
+	last = 0.0
+	for d := 0.1; d < 2; d += 0.1 {
+		x := TTestPower(20, d, 0.05)
+		if x <= last {
+			fmt.Println("failed: power does not increase with effect size ", d, x, last)
+			t.Error()
+		}
+		last = x
+	}
+}
@@ -0,0 +1,52 @@
+package stat
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"math"
+	"testing"
+)
+
+func TestProfileLikelihoodCI(t *testing.T) {
+	fmt.Println("Testing ProfileLikelihoodCI")
+	α := 0.05
+
+	// Normal mean, known σ: the interval is exact, ȳ ± z*σ/sqrt(n)
+	ȳ, σ, n := 10.0, 2.0, 25.0
+	normLik := func(μ float64) float64 {
+		return -n * (μ - ȳ) * (μ - ȳ) / (2 * σ * σ)
+	}
+	lo, hi := ProfileLikelihoodCI(normLik, ȳ, α)
+	if !check(lo, 9.216014) || !check(hi, 10.783986) {
+		fmt.Println("failed: Normal ", lo, hi)
+		t.Error()
+	}
+
+	// Poisson mean: close to the flat-prior credible interval, Gamma(sumK+1, 1/n), for moderate counts
+	for _, c := range [][2]int64{{50, 10}, {120, 40}, {300, 25}} {
+		sumK, n := c[0], c[1]
+		poisLik := func(λ float64) float64 {
+			if λ <= 0 {
+				return math.NaN()
+			}
+			return float64(sumK)*math.Log(λ) - float64(n)*λ
+		}
+		lo, hi := ProfileLikelihoodCI(poisLik, float64(sumK)/float64(n), α)
+		qtl := dst.GammaQtl(float64(sumK)+1, 1/float64(n))
+		lo1, hi1 := qtl(α/2), qtl(1-α/2)
+		if math.Abs(lo/lo1-1) > 0.03 || math.Abs(hi/hi1-1) > 0.03 {
+			fmt.Println("failed: Poisson ", sumK, n, lo, hi, lo1, hi1)
+			t.Error()
+		}
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				fmt.Println("failed: no panic for a non-finite mle")
+				t.Error()
+			}
+		}()
+		ProfileLikelihoodCI(normLik, math.NaN(), α)
+	}()
+}
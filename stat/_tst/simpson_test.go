@@ -0,0 +1,32 @@
+package stat
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Kidney stone treatment data: Charig et al. (1986) BMJ 292: 879-882.
+// Treatment A is better in both small and large stones, but worse when pooled.
+func TestStratifiedComparison(t *testing.T) {
+	fmt.Println("Testing StratifiedComparison")
+	s1 := []int64{81, 192}
+	n1 := []int64{87, 263}
+	s2 := []int64{234, 55}
+	n2 := []int64{270, 80}
+	pooled, strat, paradox := StratifiedComparison(s1, n1, s2, n2)
+	if !check(pooled, -0.04571428571428571) || !check(strat, 0.05383557024347473) || !paradox {
+		fmt.Println("failed: ", pooled, strat, paradox)
+		t.Error()
+	}
+
+	// balanced strata, no reversal
+	s1 = []int64{80, 60}
+	n1 = []int64{100, 100}
+	s2 = []int64{70, 50}
+	n2 = []int64{100, 100}
+	pooled, strat, paradox = StratifiedComparison(s1, n1, s2, n2)
+	if !check(pooled, 0.1) || !check(strat, 0.1) || paradox {
+		fmt.Println("failed: ", pooled, strat, paradox)
+		t.Error()
+	}
+}
@@ -0,0 +1,43 @@
+package stat
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNormalMuDiffTOST(t *testing.T) {
+	fmt.Println("Testing NormalMuDiffTOST")
+	// tight data centered inside the bounds
+	eq, p := NormalMuDiffTOST(10.1, 0.5, 30, 10.0, 0.6, 30, -0.5, 0.5, 0.05)
+	if !eq || p >= 0.05 {
+		fmt.Println("failed: tight data not equivalent ", eq, p)
+		t.Error()
+	}
+	// widely varying data
+	eq, p = NormalMuDiffTOST(10.1, 5, 30, 10.0, 6, 30, -0.5, 0.5, 0.05)
+	if eq || p < 0.05 {
+		fmt.Println("failed: noisy data declared equivalent ", eq, p)
+		t.Error()
+	}
+	// difference at a bound gives p = 0.5
+	_, p = NormalMuDiffTOST(10.5, 1, 20, 10.0, 1, 20, -0.5, 0.5, 0.05)
+	if !check(p, 0.5) {
+		fmt.Println("failed: p at bound ", p)
+		t.Error()
+	}
+}
+
+func TestNormalMuDiffTOSTBadSd(t *testing.T) {
+	fmt.Println("Testing NormalMuDiffTOST with bad standard deviations")
+	for _, s := range [][2]float64{{0, 1}, {1, 0}, {-1, 1}, {1, nan}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					fmt.Println("failed: no panic for ", s)
+					t.Error()
+				}
+			}()
+			NormalMuDiffTOST(10, s[0], 20, 10, s[1], 20, -0.5, 0.5, 0.05)
+		}()
+	}
+}
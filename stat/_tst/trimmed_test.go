@@ -0,0 +1,59 @@
+package stat
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"testing"
+)
+
+// On clean Normal data the trimmed mean is close to the ordinary mean.
+func TestTrimmedMeanClean(t *testing.T) {
+	fmt.Println("Testing TrimmedMean, clean data")
+	m := 100000
+	d := make([]float64, m)
+	for i := range d {
+		d[i] = dst.NormalNext(5, 2)
+	}
+	x := TrimmedMean(d, 0.1)
+	y := Mean(d)
+	if abs(x-y) > 2e-2 {
+		fmt.Println("failed: x, y ", x, y)
+		t.Error()
+	}
+}
+
+// The trimmed and winsorized means resist a large injected outlier.
+func TestTrimmedMeanOutlier(t *testing.T) {
+	fmt.Println("Testing TrimmedMean, outlier")
+	d := []float64{9.8, 10.1, 10.0, 9.9, 10.2, 10.0, 9.7, 10.3, 10.1, 1e6}
+	x := TrimmedMean(d, 0.1)
+	if abs(x-10.05) > 1e-9 {
+		fmt.Println("failed: x ", x)
+		t.Error()
+	}
+	x = WinsorizedMean(d, 0.1)
+	if abs(x-10.05) > 1e-9 {
+		fmt.Println("failed: x ", x)
+		t.Error()
+	}
+	if Mean(d) < 1e4 {
+		fmt.Println("failed: outlier not injected")
+		t.Error()
+	}
+}
+
+// Winsorized data 1, 1, 2, 3, 4, 4 give var = 1.9
+func TestWinsorizedVar(t *testing.T) {
+	fmt.Println("Testing WinsorizedVar")
+	d := []float64{4, 2, 1, 3, 100, 0}
+	x := WinsorizedVar(d, 0.2)
+	y := 1.9
+	if !check(x, y) {
+		fmt.Println("failed: x, y ", x, y)
+		t.Error()
+	}
+	if d[4] != 100 {
+		fmt.Println("failed: input modified")
+		t.Error()
+	}
+}
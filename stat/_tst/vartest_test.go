@@ -0,0 +1,21 @@
+package stat
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Montgomery 2009: soft drink fill volume, n = 20, s² = 0.0153, σ0² = 0.01, χ²(0.05, 19) = 30.14
+func TestVarianceTest(t *testing.T) {
+	fmt.Println("Testing VarianceTest")
+	chi, reject := VarianceTest(0.0153, 20, 0.01, 0.05)
+	if !check(chi, 29.07) || reject {
+		fmt.Println("failed: ", chi, reject)
+		t.Error()
+	}
+	chi, reject = VarianceTest(0.0153, 20, 0.01, 0.10) // χ²(0.10, 19) = 27.20
+	if !reject {
+		fmt.Println("failed: ", chi, reject)
+		t.Error()
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Confidence interval for the correlation coefficient, Fisher z-transform.
+// Ref.: Fisher, R.A. (1921): On the "probable error" of a coefficient of correlation deduced from a small sample. Metron 1: 3-32.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"math"
+)
+
+// CorrelationCI returns the two-sided (1-α) confidence interval for the Pearson correlation coefficient.
+func CorrelationCI(r float64, n int, α float64) (lo, hi float64) {
+	// Arguments:
+	// r - sample correlation coefficient
+	// n - number of pairs
+	// α - 1 - confidence level
+	//
+	// Details:
+	// z = atanh(r) is approximately Normal with mean atanh(ρ) and standard deviation 1/sqrt(n-3);
+	// the interval for z is transformed back with tanh.
+	if r <= -1 || r >= 1 {
+		panic(fmt.Sprintf("sample correlation r must be in (-1, 1)"))
+	}
+	if n < 4 {
+		panic(fmt.Sprintf("number of pairs n must be at least 4"))
+	}
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	z := math.Atanh(r)
+	d := dst.ZQtlFor(1-α/2) / sqrt(float64(n-3))
+	lo = math.Tanh(z - d)
+	hi = math.Tanh(z + d)
+	return
+}
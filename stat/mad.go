@@ -0,0 +1,34 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Median and median absolute deviation, robust location and scale of the data vector.
+// Ref.: Hampel 1974: The influence curve and its role in robust estimation. JASA 69: 383-393.
+
+// madNormConst makes MAD a consistent estimator of σ for Normal data, 1/Φ⁻¹(3/4).
+const madNormConst = 1.482602218505602
+
+// Median returns the median of the data vector.
+func Median(x []float64) float64 {
+	s := sortedCopy(x)
+	n := len(s)
+	if n == 0 {
+		return nan
+	}
+	if n%2 == 1 {
+		return s[n/2]
+	}
+	return (s[n/2-1] + s[n/2]) / 2
+}
+
+// MAD returns the median absolute deviation of the data vector, scaled by 1.4826 for consistency with
+// the standard deviation of the Normal distribution.
+// It is a robust alternative to the sample standard deviation.
+func MAD(x []float64) float64 {
+	m := Median(x)
+	d := make([]float64, len(x))
+	for i, val := range x {
+		d[i] = abs(val - m)
+	}
+	return madNormConst * Median(d)
+}
@@ -0,0 +1,89 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Exact test of an observed Poisson count against its expected value.
+// Two-sided p-value sums the probabilities of all counts not more likely than the observed one, as in R:poisson.test().
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"sort"
+)
+
+// poissonPMF returns the Poisson probability of k events, computed on log scale.
+func poissonPMF(λ float64, k int64) float64 {
+	return exp(-λ + float64(k)*log(λ) - dst.LogFactorial(k))
+}
+
+// poissonLower returns P(X <= k).
+func poissonLower(λ float64, k int64) float64 {
+	if k < 0 {
+		return 0
+	}
+	// P(X <= k) = P(Gamma(k+1, 1) > λ) = P(InvGamma(k+1, λ) <= 1), in time independent of k
+	return dst.InvGammaCDFAt(float64(k+1), λ, 1)
+}
+
+// poissonUpper returns P(X > k).
+func poissonUpper(λ float64, k int64) float64 {
+	if k < 0 {
+		return 1
+	}
+	// P(X >= k+1) = P(Gamma(k+1, 1) <= λ)
+	return dst.GammaCDFAt(float64(k+1), 1, λ)
+}
+
+// PoissonExactTest returns the p-value of the exact test of the observed Poisson count against the expected count.
+func PoissonExactTest(observed int64, expected float64, alternative string) float64 {
+	// Arguments:
+	// observed - observed number of events
+	// expected - expected number of events under the null hypothesis
+	// alternative - "two.sided", "less" or "greater", as in R
+	if observed < 0 {
+		panic(fmt.Sprintf("observed count must be non-negative"))
+	}
+	if isNaN(expected) || isInf(expected, 0) {
+		panic(fmt.Sprintf("expected count must be finite"))
+	}
+	if expected <= 0 {
+		panic(fmt.Sprintf("expected count must be greater than zero"))
+	}
+	m := expected
+	switch alternative {
+	case "less":
+		return poissonLower(m, observed)
+	case "greater":
+		return poissonUpper(m, observed-1)
+	case "two.sided":
+	default:
+		panic(fmt.Sprintf("alternative must be \"two.sided\", \"less\" or \"greater\""))
+	}
+
+	x := float64(observed)
+	if x == m {
+		return 1
+	}
+	const relErr = 1 + 1e-7
+	d := poissonPMF(m, observed) * relErr
+	// the PMF rises up to the mode floor(m) and falls after it, so each tail is found by bisection
+	var p float64
+	if x < m {
+		// the upper tail starts at the first point after the mode as likely as, or less likely than, the observed one
+		lo := int64(ceil(m))
+		n := int64(ceil(2*m - x))
+		for poissonPMF(m, n) > d {
+			n *= 2
+		}
+		j := lo + int64(sort.Search(int(n-lo+1), func(i int) bool { return poissonPMF(m, lo+int64(i)) <= d }))
+		p = poissonLower(m, observed) + poissonUpper(m, j-1)
+	} else {
+		// the lower tail ends before the first point as likely as the observed one
+		y := int64(sort.Search(int(floor(m))+1, func(i int) bool { return poissonPMF(m, int64(i)) > d }))
+		p = poissonLower(m, y-1) + poissonUpper(m, observed-1)
+	}
+	if p > 1 {
+		p = 1
+	}
+	return p
+}
@@ -0,0 +1,33 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Power of the two-sample t-test.
+// Ref.: Cohen, J. (1988): Statistical power analysis for the behavioral sciences, 2nd ed.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+)
+
+// TTestPower returns the power of the two-sided, two-sample t-test with equal group sizes.
+func TTestPower(n int, effectSize, α float64) float64 {
+	// Arguments:
+	// n - number of observations in each group
+	// effectSize - standardized difference of means, Cohen's d = (μ1-μ2)/σ
+	// α - significance level
+	//
+	// Returns:
+	// probability of rejecting the null hypothesis μ1 = μ2 when the effect is effectSize
+	if n < 2 {
+		panic(fmt.Sprintf("n must be at least 2"))
+	}
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	ν := float64(2*n - 2)
+	δ := effectSize * sqrt(float64(n)/2)
+	tCrit := dst.StudentsTQtlFor(ν, 1-α/2)
+	cdf := dst.NoncentralTCDF(ν, δ)
+	return 1 - cdf(tCrit) + cdf(-tCrit)
+}
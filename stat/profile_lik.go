@@ -0,0 +1,69 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Likelihood-ratio (profile likelihood) confidence interval for a scalar parameter.
+// The interval is the set of θ with 2*(logLik(mle)-logLik(θ)) <= χ²(1, 1-α).
+// Ref.: Pawitan 2001: 34-35.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+	"math"
+)
+
+// ProfileLikelihoodCI returns the likelihood-ratio confidence interval for a scalar parameter.
+// Values of θ outside the parameter space should make logLik return NaN or -Inf.
+func ProfileLikelihoodCI(logLik func(θ float64) float64, mle float64, α float64) (lo, hi float64) {
+	// logLik		log-likelihood of the parameter
+	// mle		maximum likelihood estimate
+	// α		1 - confidence level
+
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	if isNaN(mle) || isInf(mle, 0) {
+		panic(fmt.Sprintf("mle must be finite"))
+	}
+	lMax := logLik(mle)
+	if isNaN(lMax) || isInf(lMax, 0) {
+		panic(fmt.Sprintf("log-likelihood at mle must be finite"))
+	}
+	crit := dst.ChiSquareQtlFor(1, 1-α)
+
+	// g < 0 inside the interval, g > 0 outside it
+	g := func(θ float64) float64 {
+		l := logLik(θ)
+		if isNaN(l) {
+			return posInf
+		}
+		return 2*(lMax-l) - crit
+	}
+	lo = likRoot(g, mle, -1)
+	hi = likRoot(g, mle, 1)
+	return
+}
+
+// likRoot returns the root of g on the side dir of mle, where g(mle) < 0.
+func likRoot(g func(float64) float64, mle, dir float64) float64 {
+	step := 0.1 * math.Max(abs(mle), 1)
+	in, out := mle, mle+dir*step
+
+	// bracket by doubling the step
+	i := 0
+	for ; g(out) < 0; i++ {
+		if i == dst.QtlMaxIter {
+			return dir * posInf
+		}
+		in = out
+		step *= 2
+		out = mle + dir*step
+	}
+
+	// g(in) < 0 <= g(out)
+	θ, err := dst.FindRoot(g, in, out, dst.QtlTol*math.Max(math.Max(abs(in), abs(out)), 1))
+	if err != nil {
+		panic(err)
+	}
+	return θ
+}
@@ -0,0 +1,50 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Stratified versus pooled comparison of two proportions, a diagnostic for Simpson's paradox.
+// Ref.: Mantel, N. & Haenszel, W. (1959): Statistical aspects of the analysis of data from retrospective studies of disease.
+// J. Natl. Cancer Inst. 22: 719-748.
+
+import (
+	"fmt"
+)
+
+// StratifiedComparison returns the pooled difference of two proportions, the Mantel-Haenszel stratum-weighted difference,
+// and whether the two disagree in sign (Simpson's paradox).
+func StratifiedComparison(successes1, trials1, successes2, trials2 []int64) (pooledDiff, stratifiedDiff float64, paradox bool) {
+	// Arguments:
+	// successes1, trials1 - successes and trials of group 1, in each stratum
+	// successes2, trials2 - successes and trials of group 2, in each stratum
+	//
+	// Returns:
+	// pooledDiff - p1 - p2 computed from counts summed over strata
+	// stratifiedDiff - weighted mean of the stratum differences p1i - p2i, weights n1i*n2i/(n1i+n2i)
+	// paradox - true if pooledDiff and stratifiedDiff have opposite signs
+	k := len(trials1)
+	if k == 0 || len(successes1) != k || len(successes2) != k || len(trials2) != k {
+		panic(fmt.Sprintf("all vectors must be nonempty and of the same length"))
+	}
+	var s1, n1, s2, n2 int64
+	var sw, swd float64
+	for i := 0; i < k; i++ {
+		if trials1[i] <= 0 || trials2[i] <= 0 {
+			panic(fmt.Sprintf("number of trials must be greater than zero"))
+		}
+		if successes1[i] < 0 || successes1[i] > trials1[i] || successes2[i] < 0 || successes2[i] > trials2[i] {
+			panic(fmt.Sprintf("number of successes must be in [0, trials]"))
+		}
+		s1 += successes1[i]
+		n1 += trials1[i]
+		s2 += successes2[i]
+		n2 += trials2[i]
+		m1, m2 := float64(trials1[i]), float64(trials2[i])
+		w := m1 * m2 / (m1 + m2)
+		sw += w
+		swd += w * (float64(successes1[i])/m1 - float64(successes2[i])/m2)
+	}
+	pooledDiff = float64(s1)/float64(n1) - float64(s2)/float64(n2)
+	stratifiedDiff = swd / sw
+	paradox = pooledDiff*stratifiedDiff < 0
+	return
+}
@@ -0,0 +1,43 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Two one-sided tests (TOST) of equivalence of the means of two Normal samples.
+// Ref.: Schuirmann, D.J. (1987): A comparison of the two one-sided tests procedure and the power approach
+// for assessing the equivalence of average bioavailability. J. Pharmacokin. Biopharm. 15: 657-680.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/bayes"
+)
+
+// NormalMuDiffTOST performs the two one-sided tests of equivalence of the means of two Normal samples with unknown, possibly unequal variances.
+func NormalMuDiffTOST(ȳ1, s1 float64, n1 int, ȳ2, s2 float64, n2 int, lowerBound, upperBound, α float64) (equivalent bool, pValue float64) {
+	// Arguments:
+	// ȳ1, s1, n1 - mean, standard deviation and size of the first sample
+	// ȳ2, s2, n2 - mean, standard deviation and size of the second sample
+	// lowerBound, upperBound - equivalence bounds for μ1-μ2
+	// α - significance level of each one-sided test
+	//
+	// Details:
+	// Null hypothesis μ1-μ2 <= lowerBound or μ1-μ2 >= upperBound is rejected when both one-sided
+	// Welch t-tests reject at level α. Degrees of freedom are Welch-Satterthwaite.
+	// The p-values are the posterior tail probabilities of bayes.NormalMuDiffEquivTstJPri, Behrens-Fisher route.
+	//
+	// Returns:
+	// equivalent - true if the null hypothesis of non-equivalence is rejected
+	// pValue - the larger of the two one-sided p-values
+	if n1 < 2 || n2 < 2 {
+		panic(fmt.Sprintf("sample sizes must be at least 2"))
+	}
+	if !(s1 > 0) || !(s2 > 0) {
+		panic(fmt.Sprintf("standard deviations must be greater than zero"))
+	}
+	if lowerBound >= upperBound {
+		panic(fmt.Sprintf("lowerBound must be less than upperBound"))
+	}
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	return bayes.NormalMuDiffEquivTstJPri(n1, n2, ȳ1, ȳ2, s1, s2, lowerBound, upperBound, α, false)
+}
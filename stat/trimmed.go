@@ -0,0 +1,67 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// Trimmed and winsorized mean and variance, robust summaries of the data vector.
+// Ref.: Wilcox 2012 (3e): Chapter 3.
+
+import (
+	"fmt"
+	"sort"
+)
+
+// sortedCopy returns the sorted copy of the data vector, leaving x untouched.
+func sortedCopy(x []float64) []float64 {
+	s := make([]float64, len(x))
+	copy(s, x)
+	sort.Float64s(s)
+	return s
+}
+
+// nTrim returns the number of observations to be trimmed from each tail.
+func nTrim(n int, proportion float64) int {
+	if proportion < 0 || proportion >= 0.5 {
+		panic(fmt.Sprintf("proportion must be in [0, 0.5)"))
+	}
+	return int(floor(proportion * float64(n)))
+}
+
+// winsorize returns the sorted copy of the data vector with the g smallest (largest) values
+// replaced by the next smallest (largest) one.
+func winsorize(x []float64, proportion float64) []float64 {
+	w := sortedCopy(x)
+	n := len(w)
+	g := nTrim(n, proportion)
+	for i := 0; i < g; i++ {
+		w[i] = w[g]
+		w[n-1-i] = w[n-1-g]
+	}
+	return w
+}
+
+// TrimmedMean returns the mean of the data vector after removing the proportion of the smallest and of the largest values.
+func TrimmedMean(x []float64, proportion float64) float64 {
+	// Arguments:
+	// x - vector of observations
+	// proportion - fraction of observations trimmed from each tail, in [0, 0.5)
+	s := sortedCopy(x)
+	g := nTrim(len(s), proportion)
+	return Mean(s[g : len(s)-g])
+}
+
+// WinsorizedMean returns the mean of the winsorized data vector.
+func WinsorizedMean(x []float64, proportion float64) float64 {
+	// Arguments:
+	// x - vector of observations
+	// proportion - fraction of observations winsorized in each tail, in [0, 0.5)
+	return Mean(winsorize(x, proportion))
+}
+
+// WinsorizedVar returns the unbiased (Bessel correction) variance of the winsorized data vector.
+func WinsorizedVar(x []float64, proportion float64) float64 {
+	// Arguments:
+	// x - vector of observations
+	// proportion - fraction of observations winsorized in each tail, in [0, 0.5)
+	_, σ2 := SampleMeanVar(winsorize(x, proportion))
+	return σ2
+}
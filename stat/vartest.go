@@ -0,0 +1,37 @@
+// Copyright 2012 - 2013 The Probab Authors. All rights reserved. See the LICENSE file.
+
+package stat
+
+// ChiSquare test of the variance of a Normal population against a hypothesized value.
+// Ref.: Montgomery, D.C. (2009): Introduction to Statistical Quality Control, 6e: 120-121.
+
+import (
+	"fmt"
+	"github.com/datastream/probab/dst"
+)
+
+// VarianceTest performs the one-sided ChiSquare test of H0: σ² <= σ0sq against H1: σ² > σ0sq, for a Normal sample.
+func VarianceTest(sampleVar float64, n int, σ0sq, α float64) (chiStat float64, reject bool) {
+	// Arguments:
+	// sampleVar - sample variance s², with divisor n-1
+	// n - sample size
+	// σ0sq - hypothesized variance
+	// α - significance level
+	//
+	// Returns:
+	// chiStat - (n-1)s²/σ0², distributed as ChiSquare(n-1) when σ² = σ0sq
+	// reject - true if chiStat exceeds the upper α quantile of ChiSquare(n-1)
+	if n < 2 {
+		panic(fmt.Sprintf("n must be at least 2"))
+	}
+	if sampleVar < 0 || σ0sq <= 0 {
+		panic(fmt.Sprintf("variances must be positive"))
+	}
+	if !(α > 0 && α < 1) {
+		panic(fmt.Sprintf("α must be in (0, 1)"))
+	}
+	df := int64(n - 1)
+	chiStat = float64(df) * sampleVar / σ0sq
+	reject = chiStat > dst.ChiSquareQtlFor(df, 1-α)
+	return
+}